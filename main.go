@@ -12,12 +12,45 @@ func main() {
 			Name:    "oci",
 			Version: "0.1.1",
 			Rules: []tflint.Rule{
+				rules.NewOCIAutonomousDatabaseNetworkExposureRule(),
+				rules.NewOCIAutonomousDatabaseResilienceRule(),
+				rules.NewOCIBlockVolumeBackupPolicyRule(),
+				rules.NewOCIBlockVolumeCustomerManagedKeyRule(),
 				rules.NewOCIComputeInstanceInTransitEncryptionRule(),
+				rules.NewOCIComputeInstanceLegacyIMDSRule(),
+				rules.NewOCIComputeInstanceMetadataSecretsRule(),
 				rules.NewOCIComputeInstanceMonitoringRule(),
+				rules.NewOCIComputeInstancePublicIPRule(),
+				rules.NewOCIComputeInstanceShapeAllowlistRule(),
+				rules.NewOCIComputeInstanceShapeConfigRule(),
+				rules.NewOCIComputeInstanceShieldedRule(),
+				rules.NewOCIDatabaseCustomerManagedKeyRule(),
+				rules.NewOCIDatabaseHardcodedPasswordRule(),
+				rules.NewOCIDBSystemBackupRule(),
+				rules.NewOCIDefaultSecurityListRule(),
+				rules.NewOCIFileStorageExportOptionsRule(),
+				rules.NewOCIFileStorageFileSystemCustomerManagedKeyRule(),
+				rules.NewOCIIAMPolicyOverlyPermissiveRule(),
+				rules.NewOCIIAMPolicySyntaxRule(),
+				rules.NewOCILoadBalancerBackendSetHealthCheckerRule(),
+				rules.NewOCILoadBalancerBackendSetSessionPersistenceRule(),
+				rules.NewOCILoadBalancerBackendSetSSLVerifyRule(),
+				rules.NewOCILoadBalancerListenerTLSRule(),
+				rules.NewOCILoadBalancerPublicRule(),
+				rules.NewOCILoadBalancerSSLCipherSuiteRule(),
+				rules.NewOCIMySQLDBSystemResilienceRule(),
+				rules.NewOCINetworkLoadBalancerListenerProtocolRule(),
+				rules.NewOCINetworkSecurityGroupSSHRule(),
+				rules.NewOCINetworkUnrestrictedEgressRule(),
 				rules.NewOCIObjectStorageBucketPublicAccessRule(),
 				rules.NewOCIObjectStorageBucketVersioningRule(),
-				rules.NewOCINetworkSecurityGroupSSHRule(),
 				rules.NewOCIProviderHardcodedKeysRule(),
+				rules.NewOCISubnetCIDROverlapRule(),
+				rules.NewOCISubnetCIDRWithinVCNRule(),
+				rules.NewOCISubnetFlowLogsRule(),
+				rules.NewOCISubnetPrivateRule(),
+				rules.NewOCIVCNPeeringCIDROverlapRule(),
+				rules.NewOCIVolumeAttachmentInTransitEncryptionRule(),
 			},
 		},
 	})
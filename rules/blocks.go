@@ -0,0 +1,32 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+)
+
+// nestedSchema wraps a body schema in the given path of nested blocks, e.g. db_home > database
+func nestedSchema(blocks []string, body *hclext.BodySchema) *hclext.BodySchema {
+	for i := len(blocks) - 1; i >= 0; i-- {
+		body = &hclext.BodySchema{
+			Blocks: []hclext.BlockSchema{{Type: blocks[i], Body: body}},
+		}
+	}
+	return body
+}
+
+// nestedBodies returns the bodies found by following the given path of nested blocks
+func nestedBodies(body *hclext.BodyContent, blocks []string) []*hclext.BodyContent {
+	bodies := []*hclext.BodyContent{body}
+	for _, blockType := range blocks {
+		var next []*hclext.BodyContent
+		for _, b := range bodies {
+			for _, block := range b.Blocks {
+				if block.Type == blockType {
+					next = append(next, block.Body)
+				}
+			}
+		}
+		bodies = next
+	}
+	return bodies
+}
@@ -0,0 +1,92 @@
+// Package cidr parses and classifies the IPv4 and IPv6 CIDR blocks used by OCI network resources.
+package cidr
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+var privateBlocks = []netip.Prefix{
+	// RFC 1918
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	// RFC 4193 unique local addresses
+	netip.MustParsePrefix("fc00::/7"),
+}
+
+// Parse parses an IPv4 or IPv6 CIDR block the way the OCI API accepts it.
+// Leading zeros in the prefix length are allowed (e.g. "0.0.0.0/00") and host bits
+// are masked, so "10.0.0.0/0" is returned as "0.0.0.0/0".
+func Parse(s string) (netip.Prefix, error) {
+	addrPart, bitsPart, found := strings.Cut(strings.TrimSpace(s), "/")
+	if !found {
+		return netip.Prefix{}, fmt.Errorf("%q is not a CIDR block", s)
+	}
+
+	addr, err := netip.ParseAddr(addrPart)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a CIDR block: %w", s, err)
+	}
+
+	bits, err := strconv.Atoi(bitsPart)
+	if err != nil || bits < 0 || bits > addr.BitLen() {
+		return netip.Prefix{}, fmt.Errorf("%q is not a CIDR block: invalid prefix length %q", s, bitsPart)
+	}
+
+	return netip.PrefixFrom(addr, bits).Masked(), nil
+}
+
+// ParseCanonical parses a CIDR block like Parse, but rejects blocks with host bits set such as "10.0.1.5/24",
+// which VCNs and subnets don't accept
+func ParseCanonical(s string) (netip.Prefix, error) {
+	prefix, err := Parse(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	addr, _, _ := strings.Cut(strings.TrimSpace(s), "/")
+	if netip.MustParseAddr(addr) != prefix.Addr() {
+		return netip.Prefix{}, fmt.Errorf("%q is not a CIDR block: host bits are set, expected %s", s, prefix)
+	}
+	return prefix, nil
+}
+
+// IsInternetWide reports whether the block covers every address, e.g. "0.0.0.0/0" or "::/0"
+func IsInternetWide(prefix netip.Prefix) bool {
+	return prefix.Bits() == 0
+}
+
+// IsPrivate reports whether the block lies entirely within RFC 1918 (or RFC 4193 for IPv6) address space
+func IsPrivate(prefix netip.Prefix) bool {
+	for _, private := range privateBlocks {
+		if Contains(private, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRFC1918 reports whether the block lies entirely within RFC 1918 private IPv4 address space
+func IsRFC1918(prefix netip.Prefix) bool {
+	return prefix.Addr().Is4() && IsPrivate(prefix)
+}
+
+// IsBroaderThan reports whether the block is wider than a /bits block, i.e. its prefix length is shorter
+func IsBroaderThan(prefix netip.Prefix, bits int) bool {
+	return prefix.Bits() < bits
+}
+
+// Contains reports whether the inner block lies entirely within the outer block
+func Contains(outer, inner netip.Prefix) bool {
+	return outer.Addr().BitLen() == inner.Addr().BitLen() &&
+		outer.Bits() <= inner.Bits() &&
+		outer.Contains(inner.Addr())
+}
+
+// Overlaps reports whether the two blocks share any address
+func Overlaps(a, b netip.Prefix) bool {
+	return a.Overlaps(b)
+}
@@ -0,0 +1,141 @@
+package cidr
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{Name: "IPv4", Input: "10.0.1.0/24", Expected: "10.0.1.0/24"},
+		{Name: "IPv6", Input: "2001:db8::/32", Expected: "2001:db8::/32"},
+		{Name: "leading zeros in prefix length", Input: "0.0.0.0/00", Expected: "0.0.0.0/0"},
+		{Name: "host bits are masked", Input: "10.0.0.0/0", Expected: "0.0.0.0/0"},
+		{Name: "surrounding spaces", Input: " 192.168.0.0/16 ", Expected: "192.168.0.0/16"},
+		{Name: "missing prefix length", Input: "10.0.0.1", Error: true},
+		{Name: "prefix length too long", Input: "10.0.0.0/33", Error: true},
+		{Name: "service CIDR label", Input: "all-iad-services-in-oracle-services-network", Error: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			prefix, err := Parse(tc.Input)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", prefix)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if prefix.String() != tc.Expected {
+				t.Fatalf("expected %s, got %s", tc.Expected, prefix)
+			}
+		})
+	}
+}
+
+func TestParseCanonical(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input string
+		Error bool
+	}{
+		{Name: "network address", Input: "10.0.1.0/24"},
+		{Name: "IPv6 network address", Input: "2001:db8::/32"},
+		{Name: "host bits set", Input: "10.0.1.5/24", Error: true},
+		{Name: "IPv6 host bits set", Input: "2001:db8::1/32", Error: true},
+		{Name: "not a CIDR block", Input: "10.0.1.0", Error: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			prefix, err := ParseCanonical(tc.Input)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", prefix)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestClassification(t *testing.T) {
+	cases := []struct {
+		Input        string
+		InternetWide bool
+		Private      bool
+		RFC1918      bool
+		BroaderThan8 bool
+	}{
+		{Input: "0.0.0.0/0", InternetWide: true, BroaderThan8: true},
+		{Input: "::/0", InternetWide: true, BroaderThan8: true},
+		{Input: "0.0.0.0/1", BroaderThan8: true},
+		{Input: "10.0.0.0/8", Private: true, RFC1918: true},
+		{Input: "172.16.4.0/24", Private: true, RFC1918: true},
+		{Input: "172.32.0.0/16"},
+		{Input: "192.168.0.0/15"},
+		{Input: "fd00::/64", Private: true},
+		{Input: "203.0.113.0/24"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			prefix, err := Parse(tc.Input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := IsInternetWide(prefix); got != tc.InternetWide {
+				t.Errorf("IsInternetWide: expected %t, got %t", tc.InternetWide, got)
+			}
+			if got := IsPrivate(prefix); got != tc.Private {
+				t.Errorf("IsPrivate: expected %t, got %t", tc.Private, got)
+			}
+			if got := IsRFC1918(prefix); got != tc.RFC1918 {
+				t.Errorf("IsRFC1918: expected %t, got %t", tc.RFC1918, got)
+			}
+			if got := IsBroaderThan(prefix, 8); got != tc.BroaderThan8 {
+				t.Errorf("IsBroaderThan(8): expected %t, got %t", tc.BroaderThan8, got)
+			}
+		})
+	}
+}
+
+func TestContainsAndOverlaps(t *testing.T) {
+	cases := []struct {
+		A        string
+		B        string
+		Contains bool
+		Overlaps bool
+	}{
+		{A: "10.0.0.0/16", B: "10.0.1.0/24", Contains: true, Overlaps: true},
+		{A: "10.0.1.0/24", B: "10.0.0.0/16", Contains: false, Overlaps: true},
+		{A: "10.0.0.0/24", B: "10.0.1.0/24", Contains: false, Overlaps: false},
+		{A: "10.0.0.0/16", B: "10.0.0.0/16", Contains: true, Overlaps: true},
+		{A: "::/0", B: "10.0.0.0/8", Contains: false, Overlaps: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.A+" "+tc.B, func(t *testing.T) {
+			a, b := netip.MustParsePrefix(tc.A), netip.MustParsePrefix(tc.B)
+
+			if got := Contains(a, b); got != tc.Contains {
+				t.Errorf("Contains: expected %t, got %t", tc.Contains, got)
+			}
+			if got := Overlaps(a, b); got != tc.Overlaps {
+				t.Errorf("Overlaps: expected %t, got %t", tc.Overlaps, got)
+			}
+		})
+	}
+}
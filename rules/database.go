@@ -0,0 +1,17 @@
+package rules
+
+// ociDatabaseResilienceRuleConfig is the configuration shared by the database backup and resilience rules
+type ociDatabaseResilienceRuleConfig struct {
+	// ProductionTags mark a database as production, only production databases are checked
+	ProductionTags map[string]string `hclext:"production_tags,optional"`
+	// MinBackupRetentionDays is the minimum number of days backups must be kept
+	MinBackupRetentionDays int `hclext:"min_backup_retention_days,optional"`
+}
+
+// newDatabaseResilienceRuleConfig returns the configuration with its defaults
+func newDatabaseResilienceRuleConfig() *ociDatabaseResilienceRuleConfig {
+	return &ociDatabaseResilienceRuleConfig{
+		ProductionTags:         map[string]string{"environment": "production"},
+		MinBackupRetentionDays: 7,
+	}
+}
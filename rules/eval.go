@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// evaluateBool evaluates a bool expression, returning false when the value can't be determined
+func evaluateBool(runner tflint.Runner, expr hcl.Expression) (bool, bool) {
+	var value bool
+	if err := runner.EvaluateExpr(expr, &value, nil); err != nil {
+		// Skip if we can't evaluate the value (likely a variable or reference)
+		return false, false
+	}
+	return value, true
+}
+
+// evaluateNumber evaluates a numeric expression, returning false when the value can't be determined
+func evaluateNumber(runner tflint.Runner, expr hcl.Expression) (float64, bool) {
+	var value cty.Value
+	err := runner.EvaluateExpr(expr, &value, &tflint.EvaluateExprOption{WantType: &cty.Number})
+	if err != nil || value.IsNull() || !value.IsKnown() {
+		// Skip if we can't evaluate the value (likely a reference to another resource)
+		return 0, false
+	}
+
+	number, _ := value.AsBigFloat().Float64()
+	return number, true
+}
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// policyStatement is a statement of an oci_identity_policy with the range it should be reported at
+type policyStatement struct {
+	text string
+	rng  hcl.Range
+}
+
+// policyStatements evaluates the statements attribute of an oci_identity_policy. Statements written as a
+// list literal are evaluated one by one and keep the range of their element, statements that can't be
+// evaluated are skipped. Any other expression (e.g. a variable) is evaluated as a whole and reported at its range.
+func policyStatements(runner tflint.Runner, attr *hclext.Attribute) []policyStatement {
+	var statements []policyStatement
+
+	elements, diags := hcl.ExprList(attr.Expr)
+	if diags.HasErrors() {
+		var texts []string
+		if err := runner.EvaluateExpr(attr.Expr, &texts, nil); err != nil {
+			return nil
+		}
+		for _, text := range texts {
+			statements = append(statements, policyStatement{text: text, rng: attr.Expr.Range()})
+		}
+		return statements
+	}
+
+	for _, element := range elements {
+		var text string
+		if err := runner.EvaluateExpr(element, &text, nil); err != nil {
+			continue
+		}
+		statements = append(statements, policyStatement{text: text, rng: element.Range()})
+	}
+	return statements
+}
@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// launchDetailsSchema returns the oci_core_instance_configuration schema reaching
+// instance_details.launch_details with the given nested blocks and attributes
+func launchDetailsSchema(body *hclext.BodySchema) *hclext.BodySchema {
+	return &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "instance_details",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "launch_details",
+							Body: body,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// launchDetailsBlocks returns the instance_details.launch_details blocks of an oci_core_instance_configuration
+func launchDetailsBlocks(resource *hclext.Block) hclext.Blocks {
+	var launchDetails hclext.Blocks
+	for _, details := range resource.Body.Blocks {
+		if details.Type != "instance_details" {
+			continue
+		}
+		for _, block := range details.Body.Blocks {
+			if block.Type == "launch_details" {
+				launchDetails = append(launchDetails, block)
+			}
+		}
+	}
+	return launchDetails
+}
+
+// emitInstancePoolIssues reports instance pools whose instance_configuration_id references a failing configuration.
+// The message is formatted with the pool address and the address of the referenced configuration.
+func emitInstancePoolIssues(runner tflint.Runner, rule tflint.Rule, failing map[string]bool, message string) error {
+	if len(failing) == 0 {
+		return nil
+	}
+
+	pools, err := runner.GetResourceContent("oci_core_instance_pool", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "instance_configuration_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools.Blocks {
+		addr := pool.Labels[0]
+		attr, exists := pool.Body.Attributes["instance_configuration_id"]
+		if !exists {
+			continue
+		}
+
+		for _, name := range referencedResourceNames(attr.Expr, "oci_core_instance_configuration") {
+			if failing[name] {
+				runner.EmitIssue(rule, fmt.Sprintf(message, addr, "oci_core_instance_configuration."+name), attr.Expr.Range())
+			}
+		}
+	}
+	return nil
+}
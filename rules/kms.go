@@ -0,0 +1,27 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// checkKMSAttribute reports a missing key attribute (e.g. kms_key_id) at defRange and an empty one at the attribute range
+func checkKMSAttribute(runner tflint.Runner, rule tflint.Rule, body *hclext.BodyContent, attribute string, message string, defRange hcl.Range) {
+	attr, exists := body.Attributes[attribute]
+	if !exists {
+		runner.EmitIssue(rule, message, defRange)
+		return
+	}
+
+	var keyID string
+	err := runner.EvaluateExpr(attr.Expr, &keyID, nil)
+	if err != nil {
+		// Skip if we can't evaluate the key, it most likely references an oci_kms_key
+		return
+	}
+
+	if keyID == "" {
+		runner.EmitIssue(rule, message, attr.Expr.Range())
+	}
+}
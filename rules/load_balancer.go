@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// loadBalancerResourceTypes are the resource types declaring a load balancer, oci_load_balancer being the deprecated alias
+var loadBalancerResourceTypes = []string{"oci_load_balancer_load_balancer", "oci_load_balancer"}
+
+// networkLoadBalancerResourceTypes are the resource types declaring a network load balancer
+var networkLoadBalancerResourceTypes = []string{"oci_network_load_balancer_network_load_balancer"}
+
+// publicLoadBalancers returns the addresses of the load balancers of the given types that are public,
+// i.e. is_private is omitted or false. Load balancers whose is_private can't be evaluated are omitted.
+func publicLoadBalancers(runner tflint.Runner, resourceTypes []string) (map[string]bool, error) {
+	public := map[string]bool{}
+	for _, resourceType := range resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "is_private"},
+			},
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources.Blocks {
+			private := false
+			if attr, exists := resource.Body.Attributes["is_private"]; exists {
+				if err := runner.EvaluateExpr(attr.Expr, &private, nil); err != nil {
+					continue
+				}
+			}
+			if !private {
+				public[resourceAddress(resource.Labels)] = true
+			}
+		}
+	}
+	return public, nil
+}
+
+// referencedResources returns the addresses of the resources of the given types referenced in the expression
+func referencedResources(expr hcl.Expression, resourceTypes []string) []string {
+	var addrs []string
+	for _, resourceType := range resourceTypes {
+		for _, name := range referencedResourceNames(expr, resourceType) {
+			addrs = append(addrs, resourceType+"."+name)
+		}
+	}
+	return addrs
+}
@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"github.com/joelp172/tflint-ruleset-oci/rules/cidr"
+)
+
+// Public blocks wider than these prefix lengths are treated as open to the internet
+const (
+	unrestrictedIPv4Bits = 8
+	unrestrictedIPv6Bits = 16
+)
+
+// isUnrestrictedCIDR reports whether the CIDR block allows access from any address, either because it
+// is internet-wide (e.g. "0.0.0.0/0", "::/0") or because it covers a broad range of public address space
+func isUnrestrictedCIDR(block string) bool {
+	prefix, err := cidr.Parse(block)
+	if err != nil {
+		return false
+	}
+
+	if cidr.IsInternetWide(prefix) {
+		return true
+	}
+
+	bits := unrestrictedIPv4Bits
+	if prefix.Addr().Is6() {
+		bits = unrestrictedIPv6Bits
+	}
+	return cidr.IsBroaderThan(prefix, bits) && !cidr.IsPrivate(prefix)
+}
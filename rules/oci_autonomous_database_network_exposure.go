@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIAutonomousDatabaseNetworkExposureRule checks if OCI Autonomous Databases are reachable from the internet
+type OCIAutonomousDatabaseNetworkExposureRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIAutonomousDatabaseNetworkExposureRule returns a new rule
+func NewOCIAutonomousDatabaseNetworkExposureRule() *OCIAutonomousDatabaseNetworkExposureRule {
+	return &OCIAutonomousDatabaseNetworkExposureRule{}
+}
+
+// Name returns the rule name
+func (r *OCIAutonomousDatabaseNetworkExposureRule) Name() string {
+	return "oci_autonomous_database_network_exposure"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIAutonomousDatabaseNetworkExposureRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIAutonomousDatabaseNetworkExposureRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIAutonomousDatabaseNetworkExposureRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/autonomous-database-serverless/doc/network-access-options.html"
+}
+
+// Check checks if OCI Autonomous Databases with a public endpoint restrict access with an access control list and require mTLS
+func (r *OCIAutonomousDatabaseNetworkExposureRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_database_autonomous_database", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "subnet_id"},
+			{Name: "is_dedicated"},
+			{Name: "autonomous_container_database_id"},
+			{Name: "whitelisted_ips"},
+			{Name: "is_mtls_connection_required"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		// A subnet_id places the database on a private endpoint
+		if _, exists := resource.Body.Attributes["subnet_id"]; exists {
+			continue
+		}
+
+		// Dedicated databases are reached through their Exadata infrastructure network and have no public endpoint
+		if _, exists := resource.Body.Attributes["autonomous_container_database_id"]; exists {
+			continue
+		}
+		if attr, exists := resource.Body.Attributes["is_dedicated"]; exists {
+			if dedicated, ok := evaluateBool(runner, attr.Expr); !ok || dedicated {
+				continue
+			}
+		}
+
+		r.checkAccessControlList(runner, resource)
+
+		// mTLS is required by default on public endpoints
+		if attr, exists := resource.Body.Attributes["is_mtls_connection_required"]; exists {
+			var required bool
+			err := runner.EvaluateExpr(attr.Expr, &required, nil)
+			if err == nil && !required {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Autonomous Database '%s' has a public endpoint that does not require mTLS connections", addr),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// checkAccessControlList checks if whitelisted_ips is set and does not allow unrestricted CIDR blocks
+func (r *OCIAutonomousDatabaseNetworkExposureRule) checkAccessControlList(runner tflint.Runner, resource *hclext.Block) {
+	addr := resource.Labels[0]
+
+	attr, exists := resource.Body.Attributes["whitelisted_ips"]
+	if !exists {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Autonomous Database '%s' has a public endpoint without an access control list", addr),
+			resource.DefRange,
+		)
+		return
+	}
+
+	var entries []string
+	if err := runner.EvaluateExpr(attr.Expr, &entries, nil); err != nil {
+		// Skip if we can't evaluate the access control list
+		return
+	}
+
+	if len(entries) == 0 {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Autonomous Database '%s' has a public endpoint without an access control list", addr),
+			attr.Expr.Range(),
+		)
+		return
+	}
+
+	for _, entry := range entries {
+		// VCN entries (e.g. "ocid1.vcn...;10.0.0.0/24") only allow traffic through a service gateway
+		if strings.HasPrefix(entry, "ocid1.") {
+			continue
+		}
+
+		if isUnrestrictedCIDR(entry) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Autonomous Database '%s' access control list allows unrestricted access from %s", addr, entry),
+				attr.Expr.Range(),
+			)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIAutonomousDatabaseNetworkExposure(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public endpoint without access control list",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  db_name = "app"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIAutonomousDatabaseNetworkExposureRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' has a public endpoint without an access control list",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "public endpoint with empty access control list",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  whitelisted_ips = []
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIAutonomousDatabaseNetworkExposureRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' has a public endpoint without an access control list",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 21},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "public endpoint open to the internet without mTLS",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  whitelisted_ips             = ["203.0.113.0/24", "0.0.0.0/0"]
+  is_mtls_connection_required = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIAutonomousDatabaseNetworkExposureRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' access control list allows unrestricted access from 0.0.0.0/0",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 33},
+						End:      hcl.Pos{Line: 3, Column: 64},
+					},
+				},
+				{
+					Rule:    NewOCIAutonomousDatabaseNetworkExposureRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' has a public endpoint that does not require mTLS connections",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 33},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "public endpoint with broad public CIDR block",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  whitelisted_ips = ["128.0.0.0/2"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIAutonomousDatabaseNetworkExposureRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' access control list allows unrestricted access from 128.0.0.0/2",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 21},
+						End:      hcl.Pos{Line: 3, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "public endpoint with restricted access control list",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  whitelisted_ips = ["203.0.113.10", "198.51.100.0/24", "ocid1.vcn.oc1.iad.example;10.0.0.0/16"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private endpoint",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  subnet_id                   = oci_core_subnet.db.id
+  is_mtls_connection_required = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "dedicated databases",
+			Content: `
+resource "oci_database_autonomous_database" "dedicated" {
+  db_name      = "app"
+  is_dedicated = true
+}
+
+resource "oci_database_autonomous_database" "container" {
+  db_name                          = "reports"
+  autonomous_container_database_id = oci_database_autonomous_container_database.acd.id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIAutonomousDatabaseNetworkExposureRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
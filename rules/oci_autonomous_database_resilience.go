@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIAutonomousDatabaseResilienceRule checks if production OCI Autonomous Databases have Data Guard and sufficient backup retention
+type OCIAutonomousDatabaseResilienceRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIAutonomousDatabaseResilienceRule returns a new rule
+func NewOCIAutonomousDatabaseResilienceRule() *OCIAutonomousDatabaseResilienceRule {
+	return &OCIAutonomousDatabaseResilienceRule{}
+}
+
+// Name returns the rule name
+func (r *OCIAutonomousDatabaseResilienceRule) Name() string {
+	return "oci_autonomous_database_resilience"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIAutonomousDatabaseResilienceRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIAutonomousDatabaseResilienceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCIAutonomousDatabaseResilienceRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/autonomous-database-serverless/doc/autonomous-data-guard.html"
+}
+
+// Check checks if OCI Autonomous Databases tagged as production enable Data Guard and keep backups long enough
+func (r *OCIAutonomousDatabaseResilienceRule) Check(runner tflint.Runner) error {
+	config := newDatabaseResilienceRuleConfig()
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent("oci_database_autonomous_database", &hclext.BodySchema{
+		Attributes: append([]hclext.AttributeSchema{
+			{Name: "is_data_guard_enabled"},
+			{Name: "is_local_data_guard_enabled"},
+			{Name: "backup_retention_period_in_days"},
+		}, tagAttributeSchemas...),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		if !matchesAnyTag(resourceTags(runner, resource.Body), config.ProductionTags) {
+			continue
+		}
+
+		// Either a cross-region or a local standby satisfies the check
+		protected := false
+		issueRange := resource.DefRange
+		for _, attribute := range []string{"is_local_data_guard_enabled", "is_data_guard_enabled"} {
+			attr, exists := resource.Body.Attributes[attribute]
+			if !exists {
+				continue
+			}
+
+			enabled, ok := evaluateBool(runner, attr.Expr)
+			if !ok || enabled {
+				protected = true
+				break
+			}
+			issueRange = attr.Expr.Range()
+		}
+
+		if !protected {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Autonomous Database '%s' is tagged as production but does not have Data Guard enabled", addr),
+				issueRange,
+			)
+		}
+
+		// Backups are kept for 60 days by default
+		if attr, exists := resource.Body.Attributes["backup_retention_period_in_days"]; exists {
+			days, ok := evaluateNumber(runner, attr.Expr)
+			if ok && days < float64(config.MinBackupRetentionDays) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Autonomous Database '%s' is tagged as production but retains backups for %s days, less than %d", addr, formatNumber(days), config.MinBackupRetentionDays),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
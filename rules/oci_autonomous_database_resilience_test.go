@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIAutonomousDatabaseResilience(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "production database without Data Guard",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIAutonomousDatabaseResilienceRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' is tagged as production but does not have Data Guard enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "production database with Data Guard disabled and short retention",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  is_local_data_guard_enabled     = false
+  backup_retention_period_in_days = 3
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIAutonomousDatabaseResilienceRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' is tagged as production but does not have Data Guard enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 37},
+						End:      hcl.Pos{Line: 3, Column: 42},
+					},
+				},
+				{
+					Rule:    NewOCIAutonomousDatabaseResilienceRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' is tagged as production but retains backups for 3 days, less than 7",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 37},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "production database with cross-region Data Guard",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  is_data_guard_enabled           = true
+  backup_retention_period_in_days = 30
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "development database without Data Guard",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  freeform_tags = {
+    environment = "development"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "configured production tags and retention",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  is_local_data_guard_enabled     = true
+  backup_retention_period_in_days = 14
+  defined_tags = {
+    "Operations.tier" = "prod"
+  }
+}`,
+			Config: `
+rule "oci_autonomous_database_resilience" {
+  enabled                   = true
+  min_backup_retention_days = 30
+  production_tags = {
+    "Operations.tier" = "prod"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIAutonomousDatabaseResilienceRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' is tagged as production but retains backups for 14 days, less than 30",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 37},
+						End:      hcl.Pos{Line: 4, Column: 39},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOCIAutonomousDatabaseResilienceRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
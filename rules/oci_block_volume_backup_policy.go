@@ -0,0 +1,201 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// OCIBlockVolumeBackupPolicyRule checks if OCI block volumes, boot volumes and instance boot volumes have a backup policy assigned
+type OCIBlockVolumeBackupPolicyRule struct {
+	tflint.DefaultRule
+}
+
+// ociBlockVolumeBackupPolicyRuleConfig is the rule configuration
+type ociBlockVolumeBackupPolicyRuleConfig struct {
+	// ExemptTags are freeform or defined tags marking resources that don't need backups, e.g. { backup = "none" }
+	ExemptTags map[string]string `hclext:"exempt_tags,optional"`
+}
+
+// NewOCIBlockVolumeBackupPolicyRule returns a new rule
+func NewOCIBlockVolumeBackupPolicyRule() *OCIBlockVolumeBackupPolicyRule {
+	return &OCIBlockVolumeBackupPolicyRule{}
+}
+
+// Name returns the rule name
+func (r *OCIBlockVolumeBackupPolicyRule) Name() string {
+	return "oci_block_volume_backup_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIBlockVolumeBackupPolicyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIBlockVolumeBackupPolicyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCIBlockVolumeBackupPolicyRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Block/Tasks/schedulingvolumebackups.htm"
+}
+
+// Check checks if every OCI block volume, boot volume and instance boot volume in the module has a backup policy
+// assignment, either directly or through its volume group
+func (r *OCIBlockVolumeBackupPolicyRule) Check(runner tflint.Runner) error {
+	config := &ociBlockVolumeBackupPolicyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	assignments, err := runner.GetResourceContent("oci_core_volume_backup_policy_assignment", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "asset_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// Map the volumes, instances and volume groups referenced by asset_id, keyed by resource type and name
+	assigned := map[string]map[string]bool{
+		"oci_core_volume":       {},
+		"oci_core_boot_volume":  {},
+		"oci_core_instance":     {},
+		"oci_core_volume_group": {},
+	}
+	for _, assignment := range assignments.Blocks {
+		attr, exists := assignment.Body.Attributes["asset_id"]
+		if !exists {
+			continue
+		}
+		markReferencedResources(assigned, attr.Expr)
+	}
+
+	groups, err := runner.GetResourceContent("oci_core_volume_group", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "backup_policy_id"},
+			{Name: "volume_ids"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "source_details",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "volume_ids"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// Volumes in a volume group are backed up by the policy assigned to the group
+	for _, group := range groups.Blocks {
+		if !hasBackupPolicyID(runner, group.Body) && !assigned["oci_core_volume_group"][group.Labels[1]] {
+			continue
+		}
+
+		if attr, exists := group.Body.Attributes["volume_ids"]; exists {
+			markReferencedResources(assigned, attr.Expr)
+		}
+		for _, details := range group.Body.Blocks {
+			if attr, exists := details.Body.Attributes["volume_ids"]; exists {
+				markReferencedResources(assigned, attr.Expr)
+			}
+		}
+	}
+
+	targets := []struct {
+		resourceType string
+		description  string
+	}{
+		{resourceType: "oci_core_volume", description: "OCI Block Volume"},
+		{resourceType: "oci_core_boot_volume", description: "OCI Boot Volume"},
+	}
+
+	for _, target := range targets {
+		volumes, err := runner.GetResourceContent(target.resourceType, &hclext.BodySchema{
+			Attributes: append([]hclext.AttributeSchema{{Name: "backup_policy_id"}}, tagAttributeSchemas...),
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range volumes.Blocks {
+			addr := resource.Labels[0]
+			if assigned[target.resourceType][resource.Labels[1]] {
+				continue
+			}
+			if hasBackupPolicyID(runner, resource.Body) {
+				continue
+			}
+			if matchesAnyTag(resourceTags(runner, resource.Body), config.ExemptTags) {
+				continue
+			}
+
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s '%s' has no backup policy assigned", target.description, addr),
+				resource.DefRange,
+			)
+		}
+	}
+
+	instances, err := runner.GetResourceContent("oci_core_instance", &hclext.BodySchema{
+		Attributes: tagAttributeSchemas,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range instances.Blocks {
+		addr := resource.Labels[0]
+		if assigned["oci_core_instance"][resource.Labels[1]] {
+			continue
+		}
+		if matchesAnyTag(resourceTags(runner, resource.Body), config.ExemptTags) {
+			continue
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Compute Instance '%s' boot volume has no backup policy assigned", addr),
+			resource.DefRange,
+		)
+	}
+	return nil
+}
+
+// markReferencedResources marks the resources referenced by the expression as having a backup policy, keyed by
+// resource type and name
+func markReferencedResources(assigned map[string]map[string]bool, expr hcl.Expression) {
+	for resourceType, names := range assigned {
+		for _, name := range referencedResourceNames(expr, resourceType) {
+			names[name] = true
+		}
+	}
+}
+
+// hasBackupPolicyID reports whether the body sets backup_policy_id to a value other than null or an empty string.
+// Values that can't be evaluated, such as references to a backup policy, count as set.
+func hasBackupPolicyID(runner tflint.Runner, body *hclext.BodyContent) bool {
+	attr, exists := body.Attributes["backup_policy_id"]
+	if !exists {
+		return false
+	}
+
+	var value cty.Value
+	if err := runner.EvaluateExpr(attr.Expr, &value, &tflint.EvaluateExprOption{WantType: &cty.String}); err != nil {
+		return true
+	}
+	if !value.IsKnown() {
+		return true
+	}
+	return !value.IsNull() && value.AsString() != ""
+}
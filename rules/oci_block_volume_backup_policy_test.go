@@ -0,0 +1,265 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIBlockVolumeBackupPolicy(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "volume and instance without assignments",
+			Content: `
+resource "oci_core_volume" "data" {
+  size_in_gbs = 100
+}
+
+resource "oci_core_instance" "web" {
+  shape = "VM.Standard.E4.Flex"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeBackupPolicyRule(),
+					Message: "OCI Block Volume 'oci_core_volume' has no backup policy assigned",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 34},
+					},
+				},
+				{
+					Rule:    NewOCIBlockVolumeBackupPolicyRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' boot volume has no backup policy assigned",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "volume and instance boot volume with assignments",
+			Content: `
+resource "oci_core_volume" "data" {
+  size_in_gbs = 100
+}
+
+resource "oci_core_instance" "web" {
+  shape = "VM.Standard.E4.Flex"
+}
+
+resource "oci_core_volume_backup_policy_assignment" "data" {
+  asset_id  = oci_core_volume.data.id
+  policy_id = data.oci_core_volume_backup_policies.gold.volume_backup_policies[0].id
+}
+
+resource "oci_core_volume_backup_policy_assignment" "web_boot" {
+  asset_id  = oci_core_instance.web.boot_volume_id
+  policy_id = data.oci_core_volume_backup_policies.gold.volume_backup_policies[0].id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "volume with backup_policy_id",
+			Content: `
+resource "oci_core_volume" "data" {
+  backup_policy_id = data.oci_core_volume_backup_policies.silver.volume_backup_policies[0].id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "volumes with empty and null backup_policy_id",
+			Content: `
+resource "oci_core_volume" "data" {
+  backup_policy_id = ""
+}
+
+resource "oci_core_volume" "logs" {
+  backup_policy_id = null
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeBackupPolicyRule(),
+					Message: "OCI Block Volume 'oci_core_volume' has no backup policy assigned",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 34},
+					},
+				},
+				{
+					Rule:    NewOCIBlockVolumeBackupPolicyRule(),
+					Message: "OCI Block Volume 'oci_core_volume' has no backup policy assigned",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "only one of two volumes assigned",
+			Content: `
+resource "oci_core_volume" "data" {
+  size_in_gbs = 100
+}
+
+resource "oci_core_volume" "logs" {
+  size_in_gbs = 50
+}
+
+resource "oci_core_volume_backup_policy_assignment" "data" {
+  asset_id = oci_core_volume.data.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeBackupPolicyRule(),
+					Message: "OCI Block Volume 'oci_core_volume' has no backup policy assigned",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "boot volumes with and without assignments",
+			Content: `
+resource "oci_core_boot_volume" "restored" {
+  source_details {
+    type = "bootVolumeBackup"
+  }
+}
+
+resource "oci_core_boot_volume" "golden" {
+  source_details {
+    type = "bootVolume"
+  }
+}
+
+resource "oci_core_volume_backup_policy_assignment" "golden" {
+  asset_id = oci_core_boot_volume.golden.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeBackupPolicyRule(),
+					Message: "OCI Boot Volume 'oci_core_boot_volume' has no backup policy assigned",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "volumes in volume groups",
+			Content: `
+resource "oci_core_volume" "data" {
+  size_in_gbs = 100
+}
+
+resource "oci_core_instance" "web" {
+  shape = "VM.Standard.E4.Flex"
+}
+
+resource "oci_core_volume" "logs" {
+  size_in_gbs = 50
+}
+
+resource "oci_core_volume" "scratch" {
+  size_in_gbs = 50
+}
+
+resource "oci_core_volume_group" "app" {
+  source_details {
+    type       = "volumeIds"
+    volume_ids = [oci_core_volume.data.id, oci_core_instance.web.boot_volume_id]
+  }
+}
+
+resource "oci_core_volume_backup_policy_assignment" "app" {
+  asset_id = oci_core_volume_group.app.id
+}
+
+resource "oci_core_volume_group" "logs" {
+  backup_policy_id = data.oci_core_volume_backup_policies.bronze.volume_backup_policies[0].id
+
+  source_details {
+    type       = "volumeIds"
+    volume_ids = [oci_core_volume.logs.id]
+  }
+}
+
+resource "oci_core_volume_group" "scratch" {
+  source_details {
+    type       = "volumeIds"
+    volume_ids = [oci_core_volume.scratch.id]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeBackupPolicyRule(),
+					Message: "OCI Block Volume 'oci_core_volume' has no backup policy assigned",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 14, Column: 1},
+						End:      hcl.Pos{Line: 14, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "exempt by tags",
+			Content: `
+resource "oci_core_volume" "scratch" {
+  freeform_tags = {
+    backup = "none"
+  }
+}
+
+resource "oci_core_instance" "ephemeral" {
+  defined_tags = {
+    "Operations.Backup" = "none"
+  }
+}`,
+			Config: `
+rule "oci_block_volume_backup_policy" {
+  enabled     = true
+  exempt_tags = {
+    backup              = "none"
+    "Operations.Backup" = "none"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIBlockVolumeBackupPolicyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIBlockVolumeCustomerManagedKeyRule checks if OCI block and boot volumes are encrypted with a customer-managed key
+type OCIBlockVolumeCustomerManagedKeyRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIBlockVolumeCustomerManagedKeyRule returns a new rule
+func NewOCIBlockVolumeCustomerManagedKeyRule() *OCIBlockVolumeCustomerManagedKeyRule {
+	return &OCIBlockVolumeCustomerManagedKeyRule{}
+}
+
+// Name returns the rule name
+func (r *OCIBlockVolumeCustomerManagedKeyRule) Name() string {
+	return "oci_block_volume_customer_managed_key"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIBlockVolumeCustomerManagedKeyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIBlockVolumeCustomerManagedKeyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIBlockVolumeCustomerManagedKeyRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Block/Concepts/blockvolumeencryption.htm"
+}
+
+// Check checks if OCI block volumes, boot volumes and instance boot volumes set a kms_key_id
+func (r *OCIBlockVolumeCustomerManagedKeyRule) Check(runner tflint.Runner) error {
+	kmsKeySchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "kms_key_id"},
+		},
+	}
+
+	volumes := []struct {
+		resourceType string
+		description  string
+		// cloneSources are the source_details types of volumes cloned or restored from another volume
+		cloneSources []string
+	}{
+		{
+			resourceType: "oci_core_volume",
+			description:  "OCI Block Volume",
+			cloneSources: []string{"volume", "volumeBackup", "blockVolumeReplica"},
+		},
+		{
+			resourceType: "oci_core_boot_volume",
+			description:  "OCI Boot Volume",
+			cloneSources: []string{"bootVolume", "bootVolumeBackup", "bootVolumeReplica"},
+		},
+	}
+
+	for _, volume := range volumes {
+		resources, err := runner.GetResourceContent(volume.resourceType, &hclext.BodySchema{
+			Attributes: kmsKeySchema.Attributes,
+			Blocks: []hclext.BlockSchema{
+				{
+					Type: "source_details",
+					Body: &hclext.BodySchema{
+						Attributes: []hclext.AttributeSchema{
+							{Name: "type"},
+						},
+					},
+				},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			addr := resource.Labels[0]
+
+			// Clones and restores inherit the key of their source unless they set their own
+			if _, exists := resource.Body.Attributes["kms_key_id"]; !exists && isClonedVolume(runner, resource.Body, volume.cloneSources) {
+				continue
+			}
+			message := fmt.Sprintf("%s '%s' is not encrypted with a customer-managed key", volume.description, addr)
+
+			checkKMSAttribute(runner, r, resource.Body, "kms_key_id", message, resource.DefRange)
+		}
+	}
+
+	instances, err := runner.GetResourceContent("oci_core_instance", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "source_details", Body: kmsKeySchema},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range instances.Blocks {
+		addr := resource.Labels[0]
+		message := fmt.Sprintf("OCI Compute Instance '%s' boot volume is not encrypted with a customer-managed key", addr)
+
+		// Check if source_details block exists
+		if len(resource.Body.Blocks) == 0 {
+			runner.EmitIssue(r, message, resource.DefRange)
+			continue
+		}
+
+		for _, sourceDetails := range resource.Body.Blocks {
+			checkKMSAttribute(runner, r, sourceDetails.Body, "kms_key_id", message, resource.DefRange)
+		}
+	}
+	return nil
+}
+
+// isClonedVolume reports whether a volume's source_details type is one of the given clone sources
+func isClonedVolume(runner tflint.Runner, body *hclext.BodyContent, cloneSources []string) bool {
+	for _, sourceDetails := range body.Blocks {
+		attr, exists := sourceDetails.Body.Attributes["type"]
+		if !exists {
+			continue
+		}
+
+		var sourceType string
+		if err := runner.EvaluateExpr(attr.Expr, &sourceType, nil); err != nil {
+			continue
+		}
+		if slices.Contains(cloneSources, sourceType) {
+			return true
+		}
+	}
+	return false
+}
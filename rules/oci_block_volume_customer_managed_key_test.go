@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIBlockVolumeCustomerManagedKey(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "block volume without kms_key_id",
+			Content: `
+resource "oci_core_volume" "data" {
+  size_in_gbs = 100
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeCustomerManagedKeyRule(),
+					Message: "OCI Block Volume 'oci_core_volume' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "boot volume with empty kms_key_id",
+			Content: `
+resource "oci_core_boot_volume" "boot" {
+  kms_key_id = ""
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeCustomerManagedKeyRule(),
+					Message: "OCI Boot Volume 'oci_core_boot_volume' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "cloned volumes inheriting the source key",
+			Content: `
+resource "oci_core_boot_volume" "clone" {
+  source_details {
+    type = "bootVolume"
+    id   = oci_core_boot_volume.boot.id
+  }
+}
+
+resource "oci_core_boot_volume" "restore" {
+  source_details {
+    type = "bootVolumeBackup"
+    id   = "ocid1.bootvolumebackup.oc1..example"
+  }
+}
+
+resource "oci_core_volume" "clone" {
+  source_details {
+    type = "volume"
+    id   = oci_core_volume.data.id
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "cloned boot volume with empty kms_key_id",
+			Content: `
+resource "oci_core_boot_volume" "clone" {
+  kms_key_id = ""
+
+  source_details {
+    type = "bootVolume"
+    id   = oci_core_boot_volume.boot.id
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeCustomerManagedKeyRule(),
+					Message: "OCI Boot Volume 'oci_core_boot_volume' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "volumes referencing a KMS key",
+			Content: `
+resource "oci_core_volume" "data" {
+  kms_key_id = oci_kms_key.volumes.id
+}
+
+resource "oci_core_boot_volume" "boot" {
+  kms_key_id = "ocid1.key.oc1..example"
+}
+
+resource "oci_core_instance" "instance" {
+  source_details {
+    source_type = "image"
+    source_id   = "ocid1.image.oc1..example"
+    kms_key_id  = oci_kms_key.volumes.id
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "instance boot volume without kms_key_id",
+			Content: `
+resource "oci_core_instance" "instance" {
+  source_details {
+    source_type = "image"
+    source_id   = "ocid1.image.oc1..example"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIBlockVolumeCustomerManagedKeyRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' boot volume is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 40},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOCIBlockVolumeCustomerManagedKeyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
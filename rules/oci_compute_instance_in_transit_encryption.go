@@ -7,7 +7,7 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
-// OCIComputeInstanceInTransitEncryptionRule checks if OCI Compute Instance boot volume has in-transit data encryption enabled
+// OCIComputeInstanceInTransitEncryptionRule checks if OCI Compute Instance and Instance Configuration boot volumes have in-transit data encryption enabled
 type OCIComputeInstanceInTransitEncryptionRule struct {
 	tflint.DefaultRule
 }
@@ -37,19 +37,19 @@ func (r *OCIComputeInstanceInTransitEncryptionRule) Link() string {
 	return "https://docs.oracle.com/en-us/iaas/Content/Security/Reference/security_recommendations.htm"
 }
 
+var launchOptionsSchema = hclext.BlockSchema{
+	Type: "launch_options",
+	Body: &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "is_pv_encryption_in_transit_enabled"},
+		},
+	},
+}
+
 // Check checks if the OCI Compute Instance has boot volume in-transit data encryption enabled
 func (r *OCIComputeInstanceInTransitEncryptionRule) Check(runner tflint.Runner) error {
 	resources, err := runner.GetResourceContent("oci_core_instance", &hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type: "launch_options",
-				Body: &hclext.BodySchema{
-					Attributes: []hclext.AttributeSchema{
-						{Name: "is_pv_encryption_in_transit_enabled"},
-					},
-				},
-			},
-		},
+		Blocks: []hclext.BlockSchema{launchOptionsSchema},
 	}, nil)
 	if err != nil {
 		return err
@@ -57,12 +57,7 @@ func (r *OCIComputeInstanceInTransitEncryptionRule) Check(runner tflint.Runner)
 
 	for _, resource := range resources.Blocks {
 		addr := resource.Labels[0]
-		var launchOptions hclext.Blocks
-		for _, block := range resource.Body.Blocks {
-			if block.Type == "launch_options" {
-				launchOptions = append(launchOptions, block)
-			}
-		}
+		launchOptions := launchOptionsBlocks(resource.Body.Blocks)
 
 		// Check if launch_options block exists
 		if len(launchOptions) == 0 {
@@ -74,31 +69,71 @@ func (r *OCIComputeInstanceInTransitEncryptionRule) Check(runner tflint.Runner)
 			continue
 		}
 
-		for _, opts := range launchOptions {
-			attr, exists := opts.Body.Attributes["is_pv_encryption_in_transit_enabled"]
-			if !exists {
-				runner.EmitIssue(
-					r,
-					fmt.Sprintf("OCI Compute Instance '%s' does not have boot volume in-transit data encryption enabled", addr),
-					resource.DefRange,
-				)
+		message := fmt.Sprintf("OCI Compute Instance '%s' does not have boot volume in-transit data encryption enabled", addr)
+		r.checkLaunchOptions(runner, launchOptions, message, resource)
+	}
+
+	configurations, err := runner.GetResourceContent("oci_core_instance_configuration", launchDetailsSchema(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{launchOptionsSchema},
+	}), nil)
+	if err != nil {
+		return err
+	}
+
+	failing := map[string]bool{}
+	for _, resource := range configurations.Blocks {
+		addr := resource.Labels[0]
+		message := fmt.Sprintf("OCI Instance Configuration '%s' does not have boot volume in-transit data encryption enabled", addr)
+
+		for _, launchDetails := range launchDetailsBlocks(resource) {
+			launchOptions := launchOptionsBlocks(launchDetails.Body.Blocks)
+			if len(launchOptions) == 0 {
+				runner.EmitIssue(r, message, resource.DefRange)
+				failing[resource.Labels[1]] = true
 				continue
 			}
 
-			var enabled bool
-			err := runner.EvaluateExpr(attr.Expr, &enabled, nil)
-			if err != nil {
-				return err
+			if r.checkLaunchOptions(runner, launchOptions, message, resource) {
+				failing[resource.Labels[1]] = true
 			}
+		}
+	}
 
-			if !enabled {
-				runner.EmitIssue(
-					r,
-					fmt.Sprintf("OCI Compute Instance '%s' does not have boot volume in-transit data encryption enabled", addr),
-					attr.Expr.Range(),
-				)
-			}
+	return emitInstancePoolIssues(
+		runner,
+		r,
+		failing,
+		"OCI Instance Pool '%s' uses instance configuration '%s' that does not have boot volume in-transit data encryption enabled",
+	)
+}
+
+// launchOptionsBlocks returns the launch_options blocks among the given blocks
+func launchOptionsBlocks(blocks hclext.Blocks) hclext.Blocks {
+	var launchOptions hclext.Blocks
+	for _, block := range blocks {
+		if block.Type == "launch_options" {
+			launchOptions = append(launchOptions, block)
+		}
+	}
+	return launchOptions
+}
+
+// checkLaunchOptions reports launch_options blocks that do not enable in-transit encryption and returns whether any failed
+func (r *OCIComputeInstanceInTransitEncryptionRule) checkLaunchOptions(runner tflint.Runner, launchOptions hclext.Blocks, message string, resource *hclext.Block) bool {
+	failed := false
+	for _, opts := range launchOptions {
+		attr, exists := opts.Body.Attributes["is_pv_encryption_in_transit_enabled"]
+		if !exists {
+			runner.EmitIssue(r, message, resource.DefRange)
+			failed = true
+			continue
+		}
+
+		enabled, ok := evaluateBool(runner, attr.Expr)
+		if ok && !enabled {
+			runner.EmitIssue(r, message, attr.Expr.Range())
+			failed = true
 		}
 	}
-	return nil
+	return failed
 }
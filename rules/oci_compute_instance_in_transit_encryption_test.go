@@ -129,6 +129,111 @@ resource "oci_core_instance" "non_compliant_instance" {
 				},
 			},
 		},
+		{
+			Name: "instance configuration without launch_options block",
+			Content: `
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      shape = "VM.Standard2.1"
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceInTransitEncryptionRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' does not have boot volume in-transit data encryption enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 52},
+					},
+				},
+			},
+		},
+		{
+			Name: "instance configuration with encryption enabled",
+			Content: `
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      launch_options {
+        is_pv_encryption_in_transit_enabled = true
+      }
+    }
+  }
+}
+
+resource "oci_core_instance_pool" "pool" {
+  instance_configuration_id = oci_core_instance_configuration.config.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "instance pool using a configuration with encryption disabled",
+			Content: `
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      launch_options {
+        is_pv_encryption_in_transit_enabled = false
+      }
+    }
+  }
+}
+
+resource "oci_core_instance_pool" "pool" {
+  instance_configuration_id = oci_core_instance_configuration.config.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceInTransitEncryptionRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' does not have boot volume in-transit data encryption enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 47},
+						End:      hcl.Pos{Line: 8, Column: 52},
+					},
+				},
+				{
+					Rule:    NewOCIComputeInstanceInTransitEncryptionRule(),
+					Message: "OCI Instance Pool 'oci_core_instance_pool' uses instance configuration 'oci_core_instance_configuration.config' that does not have boot volume in-transit data encryption enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 15, Column: 31},
+						End:      hcl.Pos{Line: 15, Column: 72},
+					},
+				},
+			},
+		},
+		{
+			Name: "instance configuration encryption setting from a variable without a default",
+			Content: `
+variable "encrypted" {}
+
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      launch_options {
+        is_pv_encryption_in_transit_enabled = var.encrypted
+      }
+    }
+  }
+}
+
+resource "oci_core_instance_pool" "pool" {
+  instance_configuration_id = oci_core_instance_configuration.config.id
+}`,
+			Expected: helper.Issues{},
+		},
 	}
 
 	rule := NewOCIComputeInstanceInTransitEncryptionRule()
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIComputeInstanceLegacyIMDSRule checks if OCI Compute Instance has the legacy IMDS v1 endpoints disabled
+type OCIComputeInstanceLegacyIMDSRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIComputeInstanceLegacyIMDSRule returns a new rule
+func NewOCIComputeInstanceLegacyIMDSRule() *OCIComputeInstanceLegacyIMDSRule {
+	return &OCIComputeInstanceLegacyIMDSRule{}
+}
+
+// Name returns the rule name
+func (r *OCIComputeInstanceLegacyIMDSRule) Name() string {
+	return "oci_compute_instance_legacy_imds"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIComputeInstanceLegacyIMDSRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIComputeInstanceLegacyIMDSRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIComputeInstanceLegacyIMDSRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Compute/Tasks/gettingmetadata.htm#upgrading-v2"
+}
+
+var instanceOptionsSchema = hclext.BlockSchema{
+	Type: "instance_options",
+	Body: &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "are_legacy_imds_endpoints_disabled"},
+		},
+	},
+}
+
+// Check checks if OCI Compute Instances and Instance Configurations disable the legacy IMDS v1 endpoints
+func (r *OCIComputeInstanceLegacyIMDSRule) Check(runner tflint.Runner) error {
+	instances, err := runner.GetResourceContent("oci_core_instance", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{instanceOptionsSchema},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range instances.Blocks {
+		addr := resource.Labels[0]
+		message := fmt.Sprintf("OCI Compute Instance '%s' does not disable legacy IMDS v1 endpoints", addr)
+
+		r.checkInstanceOptions(runner, resource.Body.Blocks, message, resource.DefRange)
+	}
+
+	configurations, err := runner.GetResourceContent("oci_core_instance_configuration", launchDetailsSchema(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{instanceOptionsSchema},
+	}), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range configurations.Blocks {
+		addr := resource.Labels[0]
+		message := fmt.Sprintf("OCI Instance Configuration '%s' does not disable legacy IMDS v1 endpoints", addr)
+
+		for _, launchDetails := range launchDetailsBlocks(resource) {
+			r.checkInstanceOptions(runner, launchDetails.Body.Blocks, message, resource.DefRange)
+		}
+	}
+	return nil
+}
+
+// checkInstanceOptions reports a missing instance_options block at defRange and a false value at the attribute range
+func (r *OCIComputeInstanceLegacyIMDSRule) checkInstanceOptions(runner tflint.Runner, blocks hclext.Blocks, message string, defRange hcl.Range) {
+	var instanceOptions hclext.Blocks
+	for _, block := range blocks {
+		if block.Type == "instance_options" {
+			instanceOptions = append(instanceOptions, block)
+		}
+	}
+
+	// Check if instance_options block exists
+	if len(instanceOptions) == 0 {
+		runner.EmitIssue(r, message, defRange)
+		return
+	}
+
+	for _, opts := range instanceOptions {
+		attr, exists := opts.Body.Attributes["are_legacy_imds_endpoints_disabled"]
+		if !exists {
+			runner.EmitIssue(r, message, defRange)
+			continue
+		}
+
+		if disabled, ok := evaluateBool(runner, attr.Expr); ok && !disabled {
+			runner.EmitIssue(r, message, attr.Expr.Range())
+		}
+	}
+}
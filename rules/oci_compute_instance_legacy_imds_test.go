@@ -0,0 +1,169 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIComputeInstanceLegacyIMDS(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no instance_options block",
+			Content: `
+resource "oci_core_instance" "instance1" {
+  shape = "VM.Standard.E4.Flex"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceLegacyIMDSRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' does not disable legacy IMDS v1 endpoints",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "legacy endpoints enabled",
+			Content: `
+resource "oci_core_instance" "instance2" {
+  shape = "VM.Standard.E4.Flex"
+
+  instance_options {
+    are_legacy_imds_endpoints_disabled = false
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceLegacyIMDSRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' does not disable legacy IMDS v1 endpoints",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 42},
+						End:      hcl.Pos{Line: 6, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "legacy endpoints disabled",
+			Content: `
+resource "oci_core_instance" "instance3" {
+  shape = "VM.Standard.E4.Flex"
+
+  instance_options {
+    are_legacy_imds_endpoints_disabled = true
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "instance configuration without instance_options",
+			Content: `
+resource "oci_core_instance_configuration" "config1" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      shape = "VM.Standard.E4.Flex"
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceLegacyIMDSRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' does not disable legacy IMDS v1 endpoints",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 53},
+					},
+				},
+			},
+		},
+		{
+			Name: "instance configuration with legacy endpoints enabled",
+			Content: `
+resource "oci_core_instance_configuration" "config2" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      instance_options {
+        are_legacy_imds_endpoints_disabled = false
+      }
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceLegacyIMDSRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' does not disable legacy IMDS v1 endpoints",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 46},
+						End:      hcl.Pos{Line: 8, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "instance configuration with legacy endpoints disabled",
+			Content: `
+resource "oci_core_instance_configuration" "config3" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      instance_options {
+        are_legacy_imds_endpoints_disabled = true
+      }
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "instance configuration created from an existing instance",
+			Content: `
+resource "oci_core_instance_configuration" "config4" {
+  source      = "INSTANCE"
+  instance_id = "ocid1.instance.oc1..example"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "legacy endpoints setting from a variable without a default",
+			Content: `
+variable "d" {}
+
+resource "oci_core_instance" "instance1" {
+  instance_options {
+    are_legacy_imds_endpoints_disabled = var.d
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIComputeInstanceLegacyIMDSRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
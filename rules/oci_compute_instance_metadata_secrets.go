@@ -0,0 +1,290 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// minimumRSAKeyBits is the smallest RSA key size accepted in ssh_authorized_keys
+const minimumRSAKeyBits = 2048
+
+// userDataSecretPatterns are the kinds of secrets searched for in user_data
+var userDataSecretPatterns = []struct {
+	description string
+	pattern     *regexp.Regexp
+}{
+	{
+		description: "a private key",
+		pattern:     regexp.MustCompile(`-----BEGIN ((RSA|DSA|EC|OPENSSH|ENCRYPTED) )?PRIVATE KEY-----`),
+	},
+	{
+		description: "an OCI auth token",
+		pattern:     regexp.MustCompile(`(?i)\b(oci[_-]?)?auth[_-]?token\b["']?\s*[:=]\s*["']?[^\s"']{8,}`),
+	},
+	{
+		description: "an API token",
+		pattern:     regexp.MustCompile(`\b(AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{36}|xox[abprs]-[A-Za-z0-9-]{10,}|glpat-[A-Za-z0-9_-]{20})\b|(?i)\bapi[_-]?(key|token)\b["']?\s*[:=]\s*["']?[A-Za-z0-9_-]{16,}`),
+	},
+}
+
+// OCIComputeInstanceMetadataSecretsRule checks if OCI Compute Instance metadata contains secrets or unsafe SSH keys
+type OCIComputeInstanceMetadataSecretsRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIComputeInstanceMetadataSecretsRule returns a new rule
+func NewOCIComputeInstanceMetadataSecretsRule() *OCIComputeInstanceMetadataSecretsRule {
+	return &OCIComputeInstanceMetadataSecretsRule{}
+}
+
+// Name returns the rule name
+func (r *OCIComputeInstanceMetadataSecretsRule) Name() string {
+	return "oci_compute_instance_metadata_secrets"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIComputeInstanceMetadataSecretsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIComputeInstanceMetadataSecretsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIComputeInstanceMetadataSecretsRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Security/Reference/compute_security.htm"
+}
+
+// Check checks if OCI Compute Instance user_data contains secrets and ssh_authorized_keys only holds strong public keys
+func (r *OCIComputeInstanceMetadataSecretsRule) Check(runner tflint.Runner) error {
+	metadataSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "metadata"},
+		},
+	}
+
+	instances, err := runner.GetResourceContent("oci_core_instance", metadataSchema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range instances.Blocks {
+		attr, exists := resource.Body.Attributes["metadata"]
+		if !exists {
+			continue
+		}
+
+		r.checkMetadata(runner, fmt.Sprintf("OCI Compute Instance '%s'", resource.Labels[0]), attr)
+	}
+
+	configurations, err := runner.GetResourceContent("oci_core_instance_configuration", launchDetailsSchema(metadataSchema), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range configurations.Blocks {
+		for _, launchDetails := range launchDetailsBlocks(resource) {
+			attr, exists := launchDetails.Body.Attributes["metadata"]
+			if !exists {
+				continue
+			}
+
+			r.checkMetadata(runner, fmt.Sprintf("OCI Instance Configuration '%s'", resource.Labels[0]), attr)
+		}
+	}
+	return nil
+}
+
+// checkMetadata inspects the user_data and ssh_authorized_keys entries of a metadata map
+func (r *OCIComputeInstanceMetadataSecretsRule) checkMetadata(runner tflint.Runner, subject string, attr *hclext.Attribute) {
+	type entry struct {
+		content string
+		rng     hcl.Range
+	}
+	entries := map[string]entry{}
+	baseDir := filepath.Dir(attr.Range.Filename)
+
+	if object, ok := attr.Expr.(*hclsyntax.ObjectConsExpr); ok {
+		for _, item := range object.Items {
+			key, diags := item.KeyExpr.Value(nil)
+			if diags.HasErrors() || key.IsNull() || !key.Type().Equals(cty.String) {
+				continue
+			}
+			if content, ok := resolveMetadataContent(runner, item.ValueExpr, baseDir); ok {
+				entries[key.AsString()] = entry{content: content, rng: item.ValueExpr.Range()}
+			}
+		}
+	} else {
+		// The map comes from a variable or local, so entries can only be reported on the whole attribute
+		var metadata map[string]string
+		if err := runner.EvaluateExpr(attr.Expr, &metadata, nil); err != nil {
+			return
+		}
+		for key, value := range metadata {
+			entries[key] = entry{content: decodeMetadataValue(value), rng: attr.Expr.Range()}
+		}
+	}
+
+	if userData, exists := entries["user_data"]; exists {
+		for _, secret := range userDataSecretPatterns {
+			if secret.pattern.MatchString(userData.content) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("%s user_data contains %s", subject, secret.description),
+					userData.rng,
+				)
+			}
+		}
+	}
+
+	if keys, exists := entries["ssh_authorized_keys"]; exists {
+		for _, problem := range authorizedKeysProblems(keys.content) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s ssh_authorized_keys %s", subject, problem),
+				keys.rng,
+			)
+		}
+	}
+}
+
+// resolveMetadataContent returns the plain text of a metadata value. The value is evaluated by TFLint first, which
+// reads file() and templatefile() relative to the module. When that fails, base64encode() is unwrapped and
+// file() and templatefile() sources are read from disk as a fallback. Literal base64 values are decoded.
+func resolveMetadataContent(runner tflint.Runner, expr hcl.Expression, baseDir string) (string, bool) {
+	var value string
+	if err := runner.EvaluateExpr(expr, &value, nil); err == nil {
+		return decodeMetadataValue(value), true
+	}
+
+	call, ok := expr.(*hclsyntax.FunctionCallExpr)
+	if !ok || len(call.Args) == 0 {
+		return "", false
+	}
+
+	switch call.Name {
+	case "base64encode", "chomp", "trimspace":
+		return resolveMetadataContent(runner, call.Args[0], baseDir)
+	case "file", "templatefile":
+		path, ok := resolveMetadataPath(runner, call.Args[0], baseDir)
+		if !ok {
+			return "", false
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", false
+		}
+		return string(content), true
+	default:
+		return "", false
+	}
+}
+
+// decodeMetadataValue decodes a literal metadata value, as literal user_data is usually already base64 encoded
+func decodeMetadataValue(value string) string {
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil {
+		return string(decoded)
+	}
+	return value
+}
+
+// resolveMetadataPath returns the path of a file() argument relative to the directory of the configuration file.
+// Absolute paths and paths leaving the module directory are not resolved, so that only module files are read.
+func resolveMetadataPath(runner tflint.Runner, expr hcl.Expression, baseDir string) (string, bool) {
+	var path string
+
+	if template, ok := expr.(*hclsyntax.TemplateExpr); ok {
+		var sb strings.Builder
+		for _, part := range template.Parts {
+			if traversal, ok := part.(*hclsyntax.ScopeTraversalExpr); ok {
+				if traversal.Traversal.RootName() == "path" && len(traversal.Traversal) == 2 {
+					if attr, ok := traversal.Traversal[1].(hcl.TraverseAttr); ok && attr.Name == "module" {
+						sb.WriteString(".")
+						continue
+					}
+				}
+			}
+
+			var value string
+			if err := runner.EvaluateExpr(part, &value, nil); err != nil {
+				return "", false
+			}
+			sb.WriteString(value)
+		}
+		path = sb.String()
+	} else if err := runner.EvaluateExpr(expr, &path, nil); err != nil {
+		return "", false
+	}
+
+	path = filepath.FromSlash(path)
+	if !filepath.IsLocal(path) {
+		return "", false
+	}
+	return filepath.Join(baseDir, path), true
+}
+
+// authorizedKeysProblems returns a description of each private or weak key found in ssh_authorized_keys
+func authorizedKeysProblems(content string) []string {
+	if strings.Contains(content, "PRIVATE KEY-----") {
+		return []string{"contains a private key"}
+	}
+
+	var problems []string
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		switch fields[0] {
+		case "ssh-dss":
+			problems = append(problems, "contains a weak DSA key")
+		case "ssh-rsa":
+			blob, err := base64.StdEncoding.DecodeString(fields[1])
+			if err != nil {
+				continue
+			}
+			if bits, ok := rsaKeyBits(blob); ok && bits < minimumRSAKeyBits {
+				problems = append(problems, fmt.Sprintf("contains a weak %d-bit RSA key", bits))
+			}
+		}
+	}
+	return problems
+}
+
+// rsaKeyBits returns the modulus size of an "ssh-rsa" public key in the SSH wire format (RFC 4253)
+func rsaKeyBits(blob []byte) (int, bool) {
+	var fields [][]byte
+	for len(fields) < 3 {
+		if len(blob) < 4 {
+			return 0, false
+		}
+		size := binary.BigEndian.Uint32(blob)
+		blob = blob[4:]
+		if uint32(len(blob)) < size {
+			return 0, false
+		}
+		fields = append(fields, blob[:size])
+		blob = blob[size:]
+	}
+
+	if !bytes.Equal(fields[0], []byte("ssh-rsa")) {
+		return 0, false
+	}
+	return new(big.Int).SetBytes(fields[2]).BitLen(), true
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
-// OCIComputeInstanceMonitoringRule checks if OCI Compute Instance has monitoring enabled
+// OCIComputeInstanceMonitoringRule checks if OCI Compute Instance and Instance Configuration have monitoring enabled
 type OCIComputeInstanceMonitoringRule struct {
 	tflint.DefaultRule
 }
@@ -37,19 +37,19 @@ func (r *OCIComputeInstanceMonitoringRule) Link() string {
 	return "https://docs.oracle.com/en-us/iaas/Content/Monitoring/Concepts/monitoringoverview.htm"
 }
 
+var agentConfigSchema = hclext.BlockSchema{
+	Type: "agent_config",
+	Body: &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "is_monitoring_disabled"},
+		},
+	},
+}
+
 // Check checks if OCI Compute Instance has monitoring enabled
 func (r *OCIComputeInstanceMonitoringRule) Check(runner tflint.Runner) error {
 	resources, err := runner.GetResourceContent("oci_core_instance", &hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type: "agent_config",
-				Body: &hclext.BodySchema{
-					Attributes: []hclext.AttributeSchema{
-						{Name: "is_monitoring_disabled"},
-					},
-				},
-			},
-		},
+		Blocks: []hclext.BlockSchema{agentConfigSchema},
 	}, nil)
 	if err != nil {
 		return err
@@ -57,48 +57,67 @@ func (r *OCIComputeInstanceMonitoringRule) Check(runner tflint.Runner) error {
 
 	for _, resource := range resources.Blocks {
 		addr := resource.Labels[0]
-		var agentConfigs hclext.Blocks
-		for _, block := range resource.Body.Blocks {
-			if block.Type == "agent_config" {
-				agentConfigs = append(agentConfigs, block)
+		message := fmt.Sprintf("OCI Compute Instance '%s' does not have monitoring enabled", addr)
+
+		r.checkAgentConfig(runner, resource.Body.Blocks, message, resource)
+	}
+
+	configurations, err := runner.GetResourceContent("oci_core_instance_configuration", launchDetailsSchema(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{agentConfigSchema},
+	}), nil)
+	if err != nil {
+		return err
+	}
+
+	failing := map[string]bool{}
+	for _, resource := range configurations.Blocks {
+		addr := resource.Labels[0]
+		message := fmt.Sprintf("OCI Instance Configuration '%s' does not have monitoring enabled", addr)
+
+		for _, launchDetails := range launchDetailsBlocks(resource) {
+			if r.checkAgentConfig(runner, launchDetails.Body.Blocks, message, resource) {
+				failing[resource.Labels[1]] = true
 			}
 		}
+	}
 
-		// Check if agent_config block exists
-		if len(agentConfigs) == 0 {
-			runner.EmitIssue(
-				r,
-				fmt.Sprintf("OCI Compute Instance '%s' does not have monitoring enabled", addr),
-				resource.DefRange,
-			)
-			continue
+	return emitInstancePoolIssues(
+		runner,
+		r,
+		failing,
+		"OCI Instance Pool '%s' uses instance configuration '%s' that does not have monitoring enabled",
+	)
+}
+
+// checkAgentConfig reports missing or disabled monitoring in the agent_config blocks and returns whether any failed
+func (r *OCIComputeInstanceMonitoringRule) checkAgentConfig(runner tflint.Runner, blocks hclext.Blocks, message string, resource *hclext.Block) bool {
+	var agentConfigs hclext.Blocks
+	for _, block := range blocks {
+		if block.Type == "agent_config" {
+			agentConfigs = append(agentConfigs, block)
 		}
+	}
 
-		for _, config := range agentConfigs {
-			attr, exists := config.Body.Attributes["is_monitoring_disabled"]
-			if !exists {
-				runner.EmitIssue(
-					r,
-					fmt.Sprintf("OCI Compute Instance '%s' does not have monitoring enabled", addr),
-					resource.DefRange,
-				)
-				continue
-			}
+	// Check if agent_config block exists
+	if len(agentConfigs) == 0 {
+		runner.EmitIssue(r, message, resource.DefRange)
+		return true
+	}
 
-			var disabled bool
-			err := runner.EvaluateExpr(attr.Expr, &disabled, nil)
-			if err != nil {
-				return err
-			}
+	failed := false
+	for _, config := range agentConfigs {
+		attr, exists := config.Body.Attributes["is_monitoring_disabled"]
+		if !exists {
+			runner.EmitIssue(r, message, resource.DefRange)
+			failed = true
+			continue
+		}
 
-			if disabled {
-				runner.EmitIssue(
-					r,
-					fmt.Sprintf("OCI Compute Instance '%s' does not have monitoring enabled", addr),
-					attr.Expr.Range(),
-				)
-			}
+		disabled, ok := evaluateBool(runner, attr.Expr)
+		if ok && disabled {
+			runner.EmitIssue(r, message, attr.Expr.Range())
+			failed = true
 		}
 	}
-	return nil
+	return failed
 }
@@ -144,6 +144,111 @@ resource "oci_core_instance" "instance7" {
 				},
 			},
 		},
+		{
+			Name: "instance configuration without agent_config block",
+			Content: `
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      shape = "VM.Standard2.1"
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceMonitoringRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' does not have monitoring enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 52},
+					},
+				},
+			},
+		},
+		{
+			Name: "instance configuration with monitoring enabled",
+			Content: `
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      agent_config {
+        is_monitoring_disabled = false
+      }
+    }
+  }
+}
+
+resource "oci_core_instance_pool" "pool" {
+  instance_configuration_id = oci_core_instance_configuration.config.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "instance pool using a configuration with monitoring disabled",
+			Content: `
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      agent_config {
+        is_monitoring_disabled = true
+      }
+    }
+  }
+}
+
+resource "oci_core_instance_pool" "pool" {
+  instance_configuration_id = oci_core_instance_configuration.config.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceMonitoringRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' does not have monitoring enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 34},
+						End:      hcl.Pos{Line: 8, Column: 38},
+					},
+				},
+				{
+					Rule:    NewOCIComputeInstanceMonitoringRule(),
+					Message: "OCI Instance Pool 'oci_core_instance_pool' uses instance configuration 'oci_core_instance_configuration.config' that does not have monitoring enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 15, Column: 31},
+						End:      hcl.Pos{Line: 15, Column: 72},
+					},
+				},
+			},
+		},
+		{
+			Name: "instance configuration monitoring setting from a variable without a default",
+			Content: `
+variable "monitoring_disabled" {}
+
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      agent_config {
+        is_monitoring_disabled = var.monitoring_disabled
+      }
+    }
+  }
+}
+
+resource "oci_core_instance_pool" "pool" {
+  instance_configuration_id = oci_core_instance_configuration.config.id
+}`,
+			Expected: helper.Issues{},
+		},
 	}
 
 	rule := NewOCIComputeInstanceMonitoringRule()
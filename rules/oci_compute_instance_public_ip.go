@@ -0,0 +1,210 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIComputeInstancePublicIPRule checks if OCI Compute Instance VNICs are assigned public IP addresses
+type OCIComputeInstancePublicIPRule struct {
+	tflint.DefaultRule
+}
+
+// ociComputeInstancePublicIPRuleConfig is the rule configuration
+type ociComputeInstancePublicIPRuleConfig struct {
+	// ExemptResources lists the addresses of resources allowed a public IP, written as "<type>.<name>" without
+	// module or count/for_each keys, e.g. "oci_core_instance.bastion"
+	ExemptResources []string `hclext:"exempt_resources,optional"`
+}
+
+// NewOCIComputeInstancePublicIPRule returns a new rule
+func NewOCIComputeInstancePublicIPRule() *OCIComputeInstancePublicIPRule {
+	return &OCIComputeInstancePublicIPRule{}
+}
+
+// Name returns the rule name
+func (r *OCIComputeInstancePublicIPRule) Name() string {
+	return "oci_compute_instance_public_ip"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIComputeInstancePublicIPRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIComputeInstancePublicIPRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCIComputeInstancePublicIPRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Network/Tasks/managingpublicIPs.htm"
+}
+
+var createVnicDetailsSchema = hclext.BlockSchema{
+	Type: "create_vnic_details",
+	Body: &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "assign_public_ip"},
+			{Name: "subnet_id"},
+		},
+	},
+}
+
+// Check checks if OCI Compute Instance VNICs are assigned public IP addresses
+func (r *OCIComputeInstancePublicIPRule) Check(runner tflint.Runner) error {
+	config := &ociComputeInstancePublicIPRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	publicSubnets, err := r.publicSubnets(runner)
+	if err != nil {
+		return err
+	}
+
+	targets := []struct {
+		resourceType string
+		description  string
+		schema       *hclext.BodySchema
+	}{
+		{
+			resourceType: "oci_core_instance",
+			description:  "OCI Compute Instance",
+			schema:       &hclext.BodySchema{Blocks: []hclext.BlockSchema{createVnicDetailsSchema}},
+		},
+		{
+			resourceType: "oci_core_vnic_attachment",
+			description:  "OCI VNIC Attachment",
+			schema:       &hclext.BodySchema{Blocks: []hclext.BlockSchema{createVnicDetailsSchema}},
+		},
+		{
+			resourceType: "oci_core_instance_configuration",
+			description:  "OCI Instance Configuration",
+			schema: launchDetailsSchema(&hclext.BodySchema{
+				Blocks: []hclext.BlockSchema{createVnicDetailsSchema},
+			}),
+		},
+	}
+
+	for _, target := range targets {
+		resources, err := runner.GetResourceContent(target.resourceType, target.schema, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			exemption := resourceAddress(resource.Labels)
+			if slices.Contains(config.ExemptResources, exemption) {
+				continue
+			}
+
+			addr := resource.Labels[0]
+			message := fmt.Sprintf("%s '%s' assigns a public IP address to its VNIC, add '%s' to exempt_resources if it needs one", target.description, addr, exemption)
+
+			vnicDetails := resource.Body.Blocks
+			if target.resourceType == "oci_core_instance_configuration" {
+				vnicDetails = nil
+				for _, launchDetails := range launchDetailsBlocks(resource) {
+					vnicDetails = append(vnicDetails, launchDetails.Body.Blocks...)
+				}
+			}
+
+			r.checkVnicDetails(runner, vnicDetails, publicSubnets, message, resource.DefRange)
+		}
+	}
+
+	publicIPs, err := runner.GetResourceContent("oci_core_public_ip", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "private_ip_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range publicIPs.Blocks {
+		exemption := resourceAddress(resource.Labels)
+		if slices.Contains(config.ExemptResources, exemption) {
+			continue
+		}
+
+		addr := resource.Labels[0]
+		attr, exists := resource.Body.Attributes["private_ip_id"]
+		if !exists {
+			continue
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Public IP '%s' is assigned to a private IP, add '%s' to exempt_resources if it needs one", addr, exemption),
+			attr.Expr.Range(),
+		)
+	}
+	return nil
+}
+
+// publicSubnets returns whether each subnet declared in the module allows public IPs on its VNICs, keyed by name
+func (r *OCIComputeInstancePublicIPRule) publicSubnets(runner tflint.Runner) (map[string]bool, error) {
+	subnets, err := runner.GetResourceContent("oci_core_subnet", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "prohibit_public_ip_on_vnic"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	public := map[string]bool{}
+	for _, subnet := range subnets.Blocks {
+		attr, exists := subnet.Body.Attributes["prohibit_public_ip_on_vnic"]
+		if !exists {
+			public[subnet.Labels[1]] = true
+			continue
+		}
+
+		var prohibited bool
+		err := runner.EvaluateExpr(attr.Expr, &prohibited, nil)
+		if err != nil {
+			// Skip if we can't evaluate the attribute, the subnet may be private
+			continue
+		}
+		public[subnet.Labels[1]] = !prohibited
+	}
+	return public, nil
+}
+
+// checkVnicDetails reports create_vnic_details blocks that assign a public IP explicitly, or implicitly on a public subnet
+func (r *OCIComputeInstancePublicIPRule) checkVnicDetails(runner tflint.Runner, blocks hclext.Blocks, publicSubnets map[string]bool, message string, defRange hcl.Range) {
+	for _, details := range blocks {
+		if details.Type != "create_vnic_details" {
+			continue
+		}
+
+		attr, exists := details.Body.Attributes["assign_public_ip"]
+		if !exists {
+			// assign_public_ip defaults to true unless the subnet prohibits public IPs
+			subnetAttr, exists := details.Body.Attributes["subnet_id"]
+			if !exists {
+				continue
+			}
+
+			for _, name := range referencedResourceNames(subnetAttr.Expr, "oci_core_subnet") {
+				if publicSubnets[name] {
+					runner.EmitIssue(r, message, defRange)
+					break
+				}
+			}
+			continue
+		}
+
+		if assign, ok := evaluateBool(runner, attr.Expr); ok && assign {
+			runner.EmitIssue(r, message, attr.Expr.Range())
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIComputeInstancePublicIP(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public IP assigned explicitly",
+			Content: `
+resource "oci_core_instance" "web" {
+  create_vnic_details {
+    subnet_id        = "ocid1.subnet.oc1..example"
+    assign_public_ip = "true"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstancePublicIPRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' assigns a public IP address to its VNIC, add 'oci_core_instance.web' to exempt_resources if it needs one",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 24},
+						End:      hcl.Pos{Line: 5, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "public IP disabled",
+			Content: `
+resource "oci_core_instance" "web" {
+  create_vnic_details {
+    subnet_id        = oci_core_subnet.public.id
+    assign_public_ip = false
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "public IP omitted on public subnet",
+			Content: `
+resource "oci_core_subnet" "public" {
+  cidr_block = "10.0.0.0/24"
+}
+
+resource "oci_core_instance" "web" {
+  create_vnic_details {
+    subnet_id = oci_core_subnet.public.id
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstancePublicIPRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' assigns a public IP address to its VNIC, add 'oci_core_instance.web' to exempt_resources if it needs one",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "public IP omitted on private subnet",
+			Content: `
+resource "oci_core_subnet" "private" {
+  cidr_block                 = "10.0.1.0/24"
+  prohibit_public_ip_on_vnic = true
+}
+
+resource "oci_core_instance" "app" {
+  create_vnic_details {
+    subnet_id = oci_core_subnet.private.id
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "VNIC attachment and instance configuration",
+			Content: `
+resource "oci_core_vnic_attachment" "secondary" {
+  create_vnic_details {
+    assign_public_ip = true
+  }
+}
+
+resource "oci_core_instance_configuration" "pool" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      create_vnic_details {
+        assign_public_ip = true
+      }
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstancePublicIPRule(),
+					Message: "OCI VNIC Attachment 'oci_core_vnic_attachment' assigns a public IP address to its VNIC, add 'oci_core_vnic_attachment.secondary' to exempt_resources if it needs one",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 24},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+				{
+					Rule:    NewOCIComputeInstancePublicIPRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' assigns a public IP address to its VNIC, add 'oci_core_instance_configuration.pool' to exempt_resources if it needs one",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 14, Column: 28},
+						End:      hcl.Pos{Line: 14, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "reserved public IP attached to a private IP",
+			Content: `
+resource "oci_core_public_ip" "reserved" {
+  lifetime      = "RESERVED"
+  private_ip_id = data.oci_core_private_ips.web.private_ips[0].id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstancePublicIPRule(),
+					Message: "OCI Public IP 'oci_core_public_ip' is assigned to a private IP, add 'oci_core_public_ip.reserved' to exempt_resources if it needs one",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 19},
+						End:      hcl.Pos{Line: 4, Column: 66},
+					},
+				},
+			},
+		},
+		{
+			Name: "assign_public_ip from a variable without a default",
+			Content: `
+variable "assign" {}
+
+resource "oci_core_instance" "app" {
+  create_vnic_details {
+    assign_public_ip = var.assign
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "exempt bastion host",
+			Content: `
+resource "oci_core_instance" "bastion" {
+  create_vnic_details {
+    assign_public_ip = true
+  }
+}
+
+resource "oci_core_public_ip" "bastion" {
+  lifetime      = "RESERVED"
+  private_ip_id = "ocid1.privateip.oc1..example"
+}`,
+			Config: `
+rule "oci_compute_instance_public_ip" {
+  enabled          = true
+  exempt_resources = ["oci_core_instance.bastion", "oci_core_public_ip.bastion"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIComputeInstancePublicIPRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
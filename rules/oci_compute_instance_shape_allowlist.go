@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIComputeInstanceShapeAllowlistRule checks if OCI Compute Instance uses a shape from the configured allowlist
+type OCIComputeInstanceShapeAllowlistRule struct {
+	tflint.DefaultRule
+}
+
+// ociComputeInstanceShapeAllowlistRuleConfig is the rule configuration
+type ociComputeInstanceShapeAllowlistRuleConfig struct {
+	AllowedShapes []string `hclext:"allowed_shapes,optional"`
+}
+
+// NewOCIComputeInstanceShapeAllowlistRule returns a new rule
+func NewOCIComputeInstanceShapeAllowlistRule() *OCIComputeInstanceShapeAllowlistRule {
+	return &OCIComputeInstanceShapeAllowlistRule{}
+}
+
+// Name returns the rule name
+func (r *OCIComputeInstanceShapeAllowlistRule) Name() string {
+	return "oci_compute_instance_shape_allowlist"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIComputeInstanceShapeAllowlistRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *OCIComputeInstanceShapeAllowlistRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCIComputeInstanceShapeAllowlistRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Compute/References/computeshapes.htm"
+}
+
+// Check checks if OCI Compute Instances and Instance Configurations use an allowed shape
+func (r *OCIComputeInstanceShapeAllowlistRule) Check(runner tflint.Runner) error {
+	config := &ociComputeInstanceShapeAllowlistRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	// Nothing to enforce until an allowlist is configured
+	if len(config.AllowedShapes) == 0 {
+		return nil
+	}
+
+	targets, err := shapeTargets(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		attr, exists := target.body.Attributes["shape"]
+		if !exists {
+			continue
+		}
+
+		var shape string
+		err := runner.EvaluateExpr(attr.Expr, &shape, nil)
+		if err != nil {
+			// Skip if we can't evaluate the shape
+			continue
+		}
+
+		if !slices.Contains(config.AllowedShapes, shape) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s uses shape '%s' which is not in the allowed shapes", target.subject, shape),
+				attr.Expr.Range(),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIComputeInstanceShapeAllowlist(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no allowlist configured",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "BM.GPU.A10.4"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "shapes checked against the allowlist",
+			Content: `
+resource "oci_core_instance" "allowed" {
+  shape = "VM.Standard.E4.Flex"
+}
+
+resource "oci_core_instance" "denied" {
+  shape = "BM.GPU.A10.4"
+}
+
+resource "oci_core_instance_configuration" "denied" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      shape = "VM.Standard.E5.Flex"
+    }
+  }
+}`,
+			Config: `
+rule "oci_compute_instance_shape_allowlist" {
+  enabled        = true
+  allowed_shapes = ["VM.Standard.E4.Flex", "VM.Standard.A1.Flex"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeAllowlistRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' uses shape 'BM.GPU.A10.4' which is not in the allowed shapes",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+				{
+					Rule:    NewOCIComputeInstanceShapeAllowlistRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' uses shape 'VM.Standard.E5.Flex' which is not in the allowed shapes",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 15, Column: 15},
+						End:      hcl.Pos{Line: 15, Column: 36},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOCIComputeInstanceShapeAllowlistRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package rules
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIComputeInstanceShapeConfigRule checks if OCI Compute Instance shape_config is valid for the shape and within organisation limits
+type OCIComputeInstanceShapeConfigRule struct {
+	tflint.DefaultRule
+}
+
+// ociComputeInstanceShapeConfigRuleConfig is the rule configuration
+type ociComputeInstanceShapeConfigRuleConfig struct {
+	// MaxOCPUs and MaxMemoryInGBs are organisation maximums, zero means no limit
+	MaxOCPUs       float64 `hclext:"max_ocpus,optional"`
+	MaxMemoryInGBs float64 `hclext:"max_memory_in_gbs,optional"`
+}
+
+// NewOCIComputeInstanceShapeConfigRule returns a new rule
+func NewOCIComputeInstanceShapeConfigRule() *OCIComputeInstanceShapeConfigRule {
+	return &OCIComputeInstanceShapeConfigRule{}
+}
+
+// Name returns the rule name
+func (r *OCIComputeInstanceShapeConfigRule) Name() string {
+	return "oci_compute_instance_shape_config"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIComputeInstanceShapeConfigRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIComputeInstanceShapeConfigRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIComputeInstanceShapeConfigRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Compute/References/computeshapes.htm#flexible"
+}
+
+// Check checks if flexible shapes declare a shape_config sized within the shape's valid range and the organisation maximums,
+// and if fixed shapes stay within the organisation maximums
+func (r *OCIComputeInstanceShapeConfigRule) Check(runner tflint.Runner) error {
+	config := &ociComputeInstanceShapeConfigRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	targets, err := shapeTargets(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		attr, exists := target.body.Attributes["shape"]
+		if !exists {
+			continue
+		}
+
+		var shape string
+		err := runner.EvaluateExpr(attr.Expr, &shape, nil)
+		if err != nil {
+			// Skip if we can't evaluate the shape
+			continue
+		}
+
+		// Fixed shapes have no shape_config, their catalog size is checked against the organisation maximums
+		spec, known := shapeCatalog[shape]
+		if !strings.HasSuffix(shape, ".Flex") {
+			if known {
+				r.checkFixedShape(runner, target, attr, spec, config)
+			}
+			continue
+		}
+
+		var shapeConfigs hclext.Blocks
+		for _, block := range target.body.Blocks {
+			if block.Type == "shape_config" {
+				shapeConfigs = append(shapeConfigs, block)
+			}
+		}
+
+		// Check if shape_config block exists
+		if len(shapeConfigs) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s uses flexible shape '%s' without shape_config", target.subject, shape),
+				target.resource.DefRange,
+			)
+			continue
+		}
+
+		// Flexible shapes missing from the catalog are only checked against the organisation maximums
+		var shapeRange *shapeSpec
+		if known {
+			shapeRange = &spec
+		}
+		for _, shapeConfig := range shapeConfigs {
+			r.checkShapeConfig(runner, target, shape, shapeRange, shapeConfig, config)
+		}
+	}
+	return nil
+}
+
+// checkFixedShape checks the OCPUs and memory of a fixed shape against the organisation maximums
+func (r *OCIComputeInstanceShapeConfigRule) checkFixedShape(runner tflint.Runner, target shapeTarget, attr *hclext.Attribute, spec shapeSpec, config *ociComputeInstanceShapeConfigRuleConfig) {
+	if config.MaxOCPUs > 0 && spec.MaxOCPUs > config.MaxOCPUs {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s shape '%s' has %s OCPUs, exceeding the maximum of %s", target.subject, spec.Name, formatNumber(spec.MaxOCPUs), formatNumber(config.MaxOCPUs)),
+			attr.Expr.Range(),
+		)
+	}
+
+	if config.MaxMemoryInGBs > 0 && spec.MaxMemoryInGBs > config.MaxMemoryInGBs {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s shape '%s' has %s GB of memory, exceeding the maximum of %s", target.subject, spec.Name, formatNumber(spec.MaxMemoryInGBs), formatNumber(config.MaxMemoryInGBs)),
+			attr.Expr.Range(),
+		)
+	}
+}
+
+// checkShapeConfig checks the ocpus and memory_in_gbs of a shape_config block against the shape range, when
+// the shape is in the catalog, and the organisation limits
+func (r *OCIComputeInstanceShapeConfigRule) checkShapeConfig(runner tflint.Runner, target shapeTarget, shape string, spec *shapeSpec, shapeConfig *hclext.Block, config *ociComputeInstanceShapeConfigRuleConfig) {
+	ocpusAttr, exists := shapeConfig.Body.Attributes["ocpus"]
+	if !exists {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s does not set shape_config.ocpus for flexible shape '%s'", target.subject, shape),
+			target.resource.DefRange,
+		)
+		return
+	}
+
+	ocpus, ok := evaluateNumber(runner, ocpusAttr.Expr)
+	if !ok {
+		return
+	}
+
+	if spec != nil && (ocpus < spec.MinOCPUs || ocpus > spec.MaxOCPUs) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s shape_config.ocpus %s is outside the range %s-%s for shape '%s'", target.subject, formatNumber(ocpus), formatNumber(spec.MinOCPUs), formatNumber(spec.MaxOCPUs), spec.Name),
+			ocpusAttr.Expr.Range(),
+		)
+		// The memory range depends on the OCPUs, so it can't be checked against an invalid count
+		return
+	} else if config.MaxOCPUs > 0 && ocpus > config.MaxOCPUs {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s shape_config.ocpus %s exceeds the maximum of %s", target.subject, formatNumber(ocpus), formatNumber(config.MaxOCPUs)),
+			ocpusAttr.Expr.Range(),
+		)
+	}
+
+	memoryAttr, exists := shapeConfig.Body.Attributes["memory_in_gbs"]
+	if !exists {
+		return
+	}
+
+	memory, ok := evaluateNumber(runner, memoryAttr.Expr)
+	if !ok {
+		return
+	}
+
+	if spec != nil {
+		minMemory := spec.MinMemoryPerOCPU * ocpus
+		maxMemory := math.Min(spec.MaxMemoryPerOCPU*ocpus, spec.MaxMemoryInGBs)
+		if memory < minMemory || memory > maxMemory {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s shape_config.memory_in_gbs %s is outside the range %s-%s for %s OCPUs on shape '%s'", target.subject, formatNumber(memory), formatNumber(minMemory), formatNumber(maxMemory), formatNumber(ocpus), spec.Name),
+				memoryAttr.Expr.Range(),
+			)
+			return
+		}
+	}
+
+	if config.MaxMemoryInGBs > 0 && memory > config.MaxMemoryInGBs {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s shape_config.memory_in_gbs %s exceeds the maximum of %s", target.subject, formatNumber(memory), formatNumber(config.MaxMemoryInGBs)),
+			memoryAttr.Expr.Range(),
+		)
+	}
+}
+
+// formatNumber formats a number without trailing zeros
+func formatNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
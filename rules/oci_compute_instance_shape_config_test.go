@@ -0,0 +1,297 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIComputeInstanceShapeConfig(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "flexible shape without shape_config",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard.E4.Flex"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' uses flexible shape 'VM.Standard.E4.Flex' without shape_config",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "flexible shape missing from the catalog without shape_config",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard.E6.Flex"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' uses flexible shape 'VM.Standard.E6.Flex' without shape_config",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "fixed shape without shape_config",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard2.1"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "valid shape_config",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard.E4.Flex"
+
+  shape_config {
+    ocpus         = 2
+    memory_in_gbs = 32
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "large shape_config within the shape range",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard.E4.Flex"
+
+  shape_config {
+    ocpus         = 96
+    memory_in_gbs = 1536
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ocpus outside the shape range",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Optimized3.Flex"
+
+  shape_config {
+    ocpus         = 24
+    memory_in_gbs = 16
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' shape_config.ocpus 24 is outside the range 1-18 for shape 'VM.Optimized3.Flex'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 21},
+						End:      hcl.Pos{Line: 6, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "memory outside the valid ratio",
+			Content: `
+resource "oci_core_instance_configuration" "config" {
+  instance_details {
+    instance_type = "compute"
+
+    launch_details {
+      shape = "VM.Standard3.Flex"
+
+      shape_config {
+        ocpus         = 2
+        memory_in_gbs = 256
+      }
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Instance Configuration 'oci_core_instance_configuration' shape_config.memory_in_gbs 256 is outside the range 2-128 for 2 OCPUs on shape 'VM.Standard3.Flex'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 11, Column: 25},
+						End:      hcl.Pos{Line: 11, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "shape_config without ocpus",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard.A1.Flex"
+
+  shape_config {
+    memory_in_gbs = 6
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' does not set shape_config.ocpus for flexible shape 'VM.Standard.A1.Flex'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "organisation maximums",
+			Content: `
+variable "ocpus" {
+  default = 16
+}
+
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard.E4.Flex"
+
+  shape_config {
+    ocpus         = var.ocpus
+    memory_in_gbs = 256
+  }
+}`,
+			Config: `
+rule "oci_compute_instance_shape_config" {
+  enabled           = true
+  max_ocpus         = 8
+  max_memory_in_gbs = 128
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' shape_config.ocpus 16 exceeds the maximum of 8",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 10, Column: 21},
+						End:      hcl.Pos{Line: 10, Column: 30},
+					},
+				},
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' shape_config.memory_in_gbs 256 exceeds the maximum of 128",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 11, Column: 21},
+						End:      hcl.Pos{Line: 11, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "organisation maximums on a flexible shape missing from the catalog",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard.E6.Flex"
+
+  shape_config {
+    ocpus         = 16
+    memory_in_gbs = 64
+  }
+}`,
+			Config: `
+rule "oci_compute_instance_shape_config" {
+  enabled   = true
+  max_ocpus = 8
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' shape_config.ocpus 16 exceeds the maximum of 8",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 21},
+						End:      hcl.Pos{Line: 6, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "organisation maximums on a fixed shape",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "BM.Standard.E4.128"
+}`,
+			Config: `
+rule "oci_compute_instance_shape_config" {
+  enabled           = true
+  max_ocpus         = 8
+  max_memory_in_gbs = 128
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' shape 'BM.Standard.E4.128' has 128 OCPUs, exceeding the maximum of 8",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+				{
+					Rule:    NewOCIComputeInstanceShapeConfigRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' shape 'BM.Standard.E4.128' has 2048 GB of memory, exceeding the maximum of 128",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "fixed shape within the organisation maximums",
+			Content: `
+resource "oci_core_instance" "instance" {
+  shape = "VM.Standard2.1"
+}`,
+			Config: `
+rule "oci_compute_instance_shape_config" {
+  enabled           = true
+  max_ocpus         = 8
+  max_memory_in_gbs = 128
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIComputeInstanceShapeConfigRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
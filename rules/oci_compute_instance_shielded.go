@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIComputeInstanceShieldedRule checks if OCI Compute Instance is a shielded instance on shapes that support it
+type OCIComputeInstanceShieldedRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIComputeInstanceShieldedRule returns a new rule
+func NewOCIComputeInstanceShieldedRule() *OCIComputeInstanceShieldedRule {
+	return &OCIComputeInstanceShieldedRule{}
+}
+
+// Name returns the rule name
+func (r *OCIComputeInstanceShieldedRule) Name() string {
+	return "oci_compute_instance_shielded"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIComputeInstanceShieldedRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIComputeInstanceShieldedRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIComputeInstanceShieldedRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Compute/References/shielded-instances.htm"
+}
+
+// Check checks if OCI Compute Instance enables secure boot, measured boot and TPM where the shape supports them
+func (r *OCIComputeInstanceShieldedRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_core_instance", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "shape"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "platform_config",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "is_secure_boot_enabled"},
+						{Name: "is_measured_boot_enabled"},
+						{Name: "is_trusted_platform_module_enabled"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		shapeAttr, exists := resource.Body.Attributes["shape"]
+		if !exists {
+			continue
+		}
+
+		var shape string
+		err := runner.EvaluateExpr(shapeAttr.Expr, &shape, nil)
+		if err != nil {
+			// Skip if we can't evaluate the shape, we can't tell which features it supports
+			continue
+		}
+
+		// Shapes that are not in the catalog or don't support shielding (e.g. Ampere A1 or GPU shapes)
+		// reject the platform_config shielding options
+		spec, known := shapeCatalog[shape]
+		support := spec.Shielded
+		if !known || !(support.SecureBoot || support.MeasuredBoot || support.TPM) {
+			continue
+		}
+
+		var platformConfigs hclext.Blocks
+		for _, block := range resource.Body.Blocks {
+			if block.Type == "platform_config" {
+				platformConfigs = append(platformConfigs, block)
+			}
+		}
+
+		// Check if platform_config block exists
+		if len(platformConfigs) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Compute Instance '%s' is not a shielded instance", addr),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		features := []struct {
+			attribute string
+			feature   string
+			supported bool
+		}{
+			{"is_secure_boot_enabled", "secure boot", support.SecureBoot},
+			{"is_measured_boot_enabled", "measured boot", support.MeasuredBoot},
+			{"is_trusted_platform_module_enabled", "Trusted Platform Module", support.TPM},
+		}
+
+		for _, config := range platformConfigs {
+			for _, f := range features {
+				if !f.supported {
+					continue
+				}
+
+				attr, exists := config.Body.Attributes[f.attribute]
+				if !exists {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("OCI Compute Instance '%s' does not have %s enabled", addr, f.feature),
+						resource.DefRange,
+					)
+					continue
+				}
+
+				if enabled, ok := evaluateBool(runner, attr.Expr); ok && !enabled {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("OCI Compute Instance '%s' does not have %s enabled", addr, f.feature),
+						attr.Expr.Range(),
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIComputeInstanceShielded(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no platform_config block",
+			Content: `
+resource "oci_core_instance" "instance1" {
+  shape = "VM.Standard.E4.Flex"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShieldedRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' is not a shielded instance",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "all shielded features enabled",
+			Content: `
+resource "oci_core_instance" "instance2" {
+  shape = "VM.Standard.E4.Flex"
+
+  platform_config {
+    type                               = "AMD_VM"
+    is_secure_boot_enabled             = true
+    is_measured_boot_enabled           = true
+    is_trusted_platform_module_enabled = true
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "secure boot disabled and TPM not set",
+			Content: `
+resource "oci_core_instance" "instance3" {
+  shape = "VM.Standard3.Flex"
+
+  platform_config {
+    type                     = "INTEL_VM"
+    is_secure_boot_enabled   = false
+    is_measured_boot_enabled = true
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShieldedRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' does not have secure boot enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 32},
+						End:      hcl.Pos{Line: 7, Column: 37},
+					},
+				},
+				{
+					Rule:    NewOCIComputeInstanceShieldedRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' does not have Trusted Platform Module enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "shape supporting secure boot only",
+			Content: `
+resource "oci_core_instance" "instance4" {
+  shape = "BM.Standard.E3.128"
+
+  platform_config {
+    type                   = "AMD_ROME_BM"
+    is_secure_boot_enabled = true
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "shape without shielded instance support",
+			Content: `
+resource "oci_core_instance" "instance5" {
+  shape = "VM.Standard.A1.Flex"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "shape from variable",
+			Content: `
+variable "shape" {
+  default = "VM.Standard2.1"
+}
+
+resource "oci_core_instance" "instance6" {
+  shape = var.shape
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIComputeInstanceShieldedRule(),
+					Message: "OCI Compute Instance 'oci_core_instance' is not a shielded instance",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "shielded features from variables without defaults",
+			Content: `
+variable "sb" {}
+variable "mb" {}
+variable "tpm" {}
+
+resource "oci_core_instance" "instance1" {
+  shape = "VM.Standard.E4.Flex"
+
+  platform_config {
+    type                               = "AMD_VM"
+    is_secure_boot_enabled             = var.sb
+    is_measured_boot_enabled           = var.mb
+    is_trusted_platform_module_enabled = var.tpm
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIComputeInstanceShieldedRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
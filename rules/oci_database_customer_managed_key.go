@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIDatabaseCustomerManagedKeyRule checks if OCI databases are encrypted with a customer-managed key
+type OCIDatabaseCustomerManagedKeyRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIDatabaseCustomerManagedKeyRule returns a new rule
+func NewOCIDatabaseCustomerManagedKeyRule() *OCIDatabaseCustomerManagedKeyRule {
+	return &OCIDatabaseCustomerManagedKeyRule{}
+}
+
+// Name returns the rule name
+func (r *OCIDatabaseCustomerManagedKeyRule) Name() string {
+	return "oci_database_customer_managed_key"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIDatabaseCustomerManagedKeyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIDatabaseCustomerManagedKeyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIDatabaseCustomerManagedKeyRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/base-database/doc/manage-encryption-keys.html"
+}
+
+// Check checks if Autonomous Databases, Oracle databases and MySQL DB Systems are encrypted with a customer-managed key.
+// PostgreSQL DB Systems are not checked, oci_psql_db_system has no argument for a Vault key, see
+// https://registry.terraform.io/providers/oracle/oci/latest/docs/resources/psql_db_system
+func (r *OCIDatabaseCustomerManagedKeyRule) Check(runner tflint.Runner) error {
+	keySchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "kms_key_id"},
+			{Name: "vault_id"},
+		},
+	}
+
+	databases := []struct {
+		resourceType string
+		description  string
+		// blocks is the path of nested blocks holding kms_key_id and vault_id
+		blocks []string
+		// dedicated is set for Autonomous Databases, which are skipped on dedicated infrastructure
+		dedicated bool
+	}{
+		{resourceType: "oci_database_autonomous_database", description: "OCI Autonomous Database", dedicated: true},
+		{resourceType: "oci_database_database", description: "OCI Database", blocks: []string{"database"}},
+		{resourceType: "oci_database_db_home", description: "OCI DB Home", blocks: []string{"database"}},
+		{resourceType: "oci_database_db_system", description: "OCI DB System", blocks: []string{"db_home", "database"}},
+	}
+
+	for _, database := range databases {
+		schema := nestedSchema(database.blocks, keySchema)
+		if database.dedicated {
+			schema = &hclext.BodySchema{
+				Attributes: append([]hclext.AttributeSchema{
+					{Name: "is_dedicated"},
+					{Name: "autonomous_container_database_id"},
+				}, keySchema.Attributes...),
+			}
+		}
+
+		resources, err := runner.GetResourceContent(database.resourceType, schema, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			addr := resource.Labels[0]
+
+			// Dedicated databases are encrypted with the key of their Autonomous Container Database
+			if database.dedicated && isDedicatedAutonomousDatabase(runner, resource.Body) {
+				continue
+			}
+			message := fmt.Sprintf("%s '%s' is not encrypted with a customer-managed key", database.description, addr)
+
+			bodies := nestedBodies(resource.Body, database.blocks)
+			if len(bodies) == 0 {
+				runner.EmitIssue(r, message, resource.DefRange)
+				continue
+			}
+
+			for _, body := range bodies {
+				checkKMSAttribute(runner, r, body, "kms_key_id", message, resource.DefRange)
+				checkKMSAttribute(runner, r, body, "vault_id", fmt.Sprintf("%s '%s' does not set the vault_id of its customer-managed key", database.description, addr), resource.DefRange)
+			}
+		}
+	}
+
+	return r.checkMySQLDBSystems(runner)
+}
+
+// isDedicatedAutonomousDatabase reports whether an Autonomous Database runs on dedicated infrastructure, either
+// through an autonomous_container_database_id or is_dedicated. Values that can't be evaluated count as dedicated.
+func isDedicatedAutonomousDatabase(runner tflint.Runner, body *hclext.BodyContent) bool {
+	if _, exists := body.Attributes["autonomous_container_database_id"]; exists {
+		return true
+	}
+	if attr, exists := body.Attributes["is_dedicated"]; exists {
+		if dedicated, ok := evaluateBool(runner, attr.Expr); !ok || dedicated {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMySQLDBSystems checks if MySQL DB Systems bring their own key through encrypt_data
+func (r *OCIDatabaseCustomerManagedKeyRule) checkMySQLDBSystems(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_mysql_mysql_db_system", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "encrypt_data",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "key_generation_type"},
+						{Name: "key_id"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+		message := fmt.Sprintf("OCI MySQL DB System '%s' is not encrypted with a customer-managed key", addr)
+
+		// Check if encrypt_data block exists
+		if len(resource.Body.Blocks) == 0 {
+			runner.EmitIssue(r, message, resource.DefRange)
+			continue
+		}
+
+		for _, encryptData := range resource.Body.Blocks {
+			attr, exists := encryptData.Body.Attributes["key_generation_type"]
+			if !exists {
+				runner.EmitIssue(r, message, resource.DefRange)
+				continue
+			}
+
+			var keyGenerationType string
+			err := runner.EvaluateExpr(attr.Expr, &keyGenerationType, nil)
+			if err != nil {
+				// Skip if we can't evaluate the key generation type
+				continue
+			}
+
+			if keyGenerationType != "BYOK" {
+				runner.EmitIssue(r, message, attr.Expr.Range())
+				continue
+			}
+
+			checkKMSAttribute(runner, r, encryptData.Body, "key_id", message, resource.DefRange)
+		}
+	}
+	return nil
+}
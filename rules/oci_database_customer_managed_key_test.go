@@ -0,0 +1,187 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIDatabaseCustomerManagedKey(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "autonomous database without key",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  db_name = "app"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseCustomerManagedKeyRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+				{
+					Rule:    NewOCIDatabaseCustomerManagedKeyRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' does not set the vault_id of its customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "autonomous database with key and vault",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  kms_key_id = oci_kms_key.db.id
+  vault_id   = oci_kms_vault.db.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "autonomous database in a container database",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  db_name                          = "app"
+  autonomous_container_database_id = oci_database_autonomous_container_database.acd.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "dedicated autonomous database",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  db_name      = "app"
+  is_dedicated = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "database with empty kms_key_id",
+			Content: `
+resource "oci_database_database" "db" {
+  database {
+    kms_key_id = ""
+    vault_id   = oci_kms_vault.db.id
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseCustomerManagedKeyRule(),
+					Message: "OCI Database 'oci_database_database' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 18},
+						End:      hcl.Pos{Line: 4, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "DB home without vault_id",
+			Content: `
+resource "oci_database_db_home" "home" {
+  database {
+    kms_key_id = oci_kms_key.db.id
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseCustomerManagedKeyRule(),
+					Message: "OCI DB Home 'oci_database_db_home' does not set the vault_id of its customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "DB system database with key and vault",
+			Content: `
+resource "oci_database_db_system" "db" {
+  db_home {
+    database {
+      kms_key_id = oci_kms_key.db.id
+      vault_id   = oci_kms_vault.db.id
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "MySQL DB system with system generated key",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  encrypt_data {
+    key_generation_type = "SYSTEM"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseCustomerManagedKeyRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 4, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL DB system without encrypt_data",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  shape_name = "MySQL.2"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseCustomerManagedKeyRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL DB system with own key",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  encrypt_data {
+    key_generation_type = "BYOK"
+    key_id              = oci_kms_key.mysql.id
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIDatabaseCustomerManagedKeyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIDatabaseHardcodedPasswordRule checks if OCI database admin passwords are hard coded
+type OCIDatabaseHardcodedPasswordRule struct {
+	tflint.DefaultRule
+}
+
+// passwordTarget is a resource type holding a database password in a nested attribute
+type passwordTarget struct {
+	resourceType string
+	subject      string
+	// blocks is the path of nested blocks holding the attribute, e.g. db_home > database
+	blocks    []string
+	attribute string
+	// advice recommends an alternative to the hard-coded password
+	advice string
+}
+
+var passwordTargets = []passwordTarget{
+	{
+		resourceType: "oci_database_autonomous_database",
+		subject:      "OCI Autonomous Database",
+		attribute:    "admin_password",
+		advice:       "use secret_id to read it from an OCI Vault secret",
+	},
+	{
+		resourceType: "oci_database_db_system",
+		subject:      "OCI DB System",
+		blocks:       []string{"db_home", "database"},
+		attribute:    "admin_password",
+		advice:       "pass it in through a sensitive variable without a default",
+	},
+	{
+		resourceType: "oci_mysql_mysql_db_system",
+		subject:      "OCI MySQL DB System",
+		attribute:    "admin_password",
+		advice:       "pass it in through a sensitive variable without a default",
+	},
+	{
+		resourceType: "oci_psql_db_system",
+		subject:      "OCI PostgreSQL DB System",
+		blocks:       []string{"credentials", "password_details"},
+		attribute:    "password",
+		advice:       "use password_type \"VAULT_SECRET\" with secret_id",
+	},
+}
+
+// schema returns the body schema reaching the password attribute through the nested blocks
+func (t passwordTarget) schema() *hclext.BodySchema {
+	return nestedSchema(t.blocks, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: t.attribute}},
+	})
+}
+
+// attributes returns the password attributes found through the nested blocks
+func (t passwordTarget) attributes(body *hclext.BodyContent) []*hclext.Attribute {
+	var attrs []*hclext.Attribute
+	for _, b := range nestedBodies(body, t.blocks) {
+		if attr, exists := b.Attributes[t.attribute]; exists {
+			attrs = append(attrs, attr)
+		}
+	}
+	return attrs
+}
+
+// NewOCIDatabaseHardcodedPasswordRule returns a new rule
+func NewOCIDatabaseHardcodedPasswordRule() *OCIDatabaseHardcodedPasswordRule {
+	return &OCIDatabaseHardcodedPasswordRule{}
+}
+
+// Name returns the rule name
+func (r *OCIDatabaseHardcodedPasswordRule) Name() string {
+	return "oci_database_hardcoded_password"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIDatabaseHardcodedPasswordRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIDatabaseHardcodedPasswordRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIDatabaseHardcodedPasswordRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/KeyManagement/Tasks/managingsecrets.htm"
+}
+
+// Check checks if OCI database admin passwords are literals or variables with literal defaults
+func (r *OCIDatabaseHardcodedPasswordRule) Check(runner tflint.Runner) error {
+	defaults, err := variableDefaults(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range passwordTargets {
+		resources, err := runner.GetResourceContent(target.resourceType, target.schema(), nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			addr := resource.Labels[0]
+
+			for _, attr := range target.attributes(resource.Body) {
+				// A variable is only hard coded when its default is
+				if name, ok := variableName(attr.Expr); ok {
+					defaultAttr, exists := defaults[name]
+					if exists && isLiteralString(runner, defaultAttr.Expr) {
+						runner.EmitIssue(
+							r,
+							fmt.Sprintf("%s '%s' %s uses variable '%s' with a hard-coded default, %s", target.subject, addr, target.attribute, name, target.advice),
+							defaultAttr.Expr.Range(),
+						)
+					}
+					continue
+				}
+
+				if isLiteralString(runner, attr.Expr) {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("%s '%s' has a hard-coded %s, %s", target.subject, addr, target.attribute, target.advice),
+						attr.Expr.Range(),
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// variableDefaults returns the default attribute of each variable declared in the module, keyed by name
+func variableDefaults(runner tflint.Runner) (map[string]*hclext.Attribute, error) {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "default"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := map[string]*hclext.Attribute{}
+	for _, variable := range content.Blocks {
+		if attr, exists := variable.Body.Attributes["default"]; exists {
+			defaults[variable.Labels[0]] = attr
+		}
+	}
+	return defaults, nil
+}
+
+// variableName returns the name of the variable when the expression is exactly a reference to it, e.g. var.db_password
+func variableName(expr hcl.Expression) (string, bool) {
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() || traversal.RootName() != "var" || len(traversal) != 2 {
+		return "", false
+	}
+
+	attr, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	return attr.Name, true
+}
+
+// isLiteralString reports whether the expression is a non-empty string that doesn't reference any variable or resource
+func isLiteralString(runner tflint.Runner, expr hcl.Expression) bool {
+	if len(expr.Variables()) > 0 {
+		return false
+	}
+
+	var value string
+	if err := runner.EvaluateExpr(expr, &value, nil); err != nil {
+		return false
+	}
+	return value != ""
+}
@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIDatabaseHardcodedPassword(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "autonomous database with literal password",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  admin_password = "Welcome12345#"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseHardcodedPasswordRule(),
+					Message: "OCI Autonomous Database 'oci_database_autonomous_database' has a hard-coded admin_password, use secret_id to read it from an OCI Vault secret",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "DB system database with literal password",
+			Content: `
+resource "oci_database_db_system" "db" {
+  db_home {
+    database {
+      admin_password = "Welcome12345#"
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseHardcodedPasswordRule(),
+					Message: "OCI DB System 'oci_database_db_system' has a hard-coded admin_password, pass it in through a sensitive variable without a default",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 24},
+						End:      hcl.Pos{Line: 5, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL DB system with variable default",
+			Content: `
+variable "mysql_password" {
+  type      = string
+  default   = "Welcome12345#"
+  sensitive = true
+}
+
+resource "oci_mysql_mysql_db_system" "mysql" {
+  admin_password = var.mysql_password
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseHardcodedPasswordRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' admin_password uses variable 'mysql_password' with a hard-coded default, pass it in through a sensitive variable without a default",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 15},
+						End:      hcl.Pos{Line: 4, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "PostgreSQL DB system with plain text password",
+			Content: `
+resource "oci_psql_db_system" "psql" {
+  credentials {
+    username = "admin"
+    password_details {
+      password_type = "PLAIN_TEXT"
+      password      = "Welcome12345#"
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDatabaseHardcodedPasswordRule(),
+					Message: "OCI PostgreSQL DB System 'oci_psql_db_system' has a hard-coded password, use password_type \"VAULT_SECRET\" with secret_id",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 7, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "PostgreSQL DB system with vault secret",
+			Content: `
+resource "oci_psql_db_system" "psql" {
+  credentials {
+    username = "admin"
+    password_details {
+      password_type  = "VAULT_SECRET"
+      secret_id      = oci_vault_secret.psql.id
+      secret_version = "1"
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "variable without default",
+			Content: `
+variable "adb_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "oci_database_autonomous_database" "adb" {
+  admin_password = var.adb_password
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "autonomous database with secret_id",
+			Content: `
+resource "oci_database_autonomous_database" "adb" {
+  secret_id             = oci_vault_secret.adb.id
+  secret_version_number = 1
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIDatabaseHardcodedPasswordRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
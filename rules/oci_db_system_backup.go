@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIDBSystemBackupRule checks if production OCI DB System databases have automatic backups enabled
+type OCIDBSystemBackupRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIDBSystemBackupRule returns a new rule
+func NewOCIDBSystemBackupRule() *OCIDBSystemBackupRule {
+	return &OCIDBSystemBackupRule{}
+}
+
+// Name returns the rule name
+func (r *OCIDBSystemBackupRule) Name() string {
+	return "oci_db_system_backup"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIDBSystemBackupRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIDBSystemBackupRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCIDBSystemBackupRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/base-database/doc/backing-database-using-oci-console.html"
+}
+
+// Check checks if databases of OCI DB Systems tagged as production enable automatic backups with enough retention
+func (r *OCIDBSystemBackupRule) Check(runner tflint.Runner) error {
+	config := newDatabaseResilienceRuleConfig()
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent("oci_database_db_system", &hclext.BodySchema{
+		Attributes: tagAttributeSchemas,
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "db_home",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "database",
+							Body: &hclext.BodySchema{
+								Blocks: []hclext.BlockSchema{
+									{
+										Type: "db_backup_config",
+										Body: &hclext.BodySchema{
+											Attributes: []hclext.AttributeSchema{
+												{Name: "auto_backup_enabled"},
+												{Name: "recovery_window_in_days"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		if !matchesAnyTag(resourceTags(runner, resource.Body), config.ProductionTags) {
+			continue
+		}
+
+		var backupConfigs hclext.Blocks
+		for _, dbHome := range resource.Body.Blocks {
+			for _, database := range dbHome.Body.Blocks {
+				backupConfigs = append(backupConfigs, database.Body.Blocks...)
+			}
+		}
+
+		// Automatic backups are disabled unless db_backup_config enables them
+		if len(backupConfigs) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI DB System '%s' is tagged as production but does not have automatic backups enabled", addr),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		for _, backupConfig := range backupConfigs {
+			attr, exists := backupConfig.Body.Attributes["auto_backup_enabled"]
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI DB System '%s' is tagged as production but does not have automatic backups enabled", addr),
+					resource.DefRange,
+				)
+				continue
+			}
+
+			if enabled, ok := evaluateBool(runner, attr.Expr); ok && !enabled {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI DB System '%s' is tagged as production but does not have automatic backups enabled", addr),
+					attr.Expr.Range(),
+				)
+				continue
+			}
+
+			if windowAttr, exists := backupConfig.Body.Attributes["recovery_window_in_days"]; exists {
+				days, ok := evaluateNumber(runner, windowAttr.Expr)
+				if ok && days < float64(config.MinBackupRetentionDays) {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("OCI DB System '%s' is tagged as production but retains backups for %s days, less than %d", addr, formatNumber(days), config.MinBackupRetentionDays),
+						windowAttr.Expr.Range(),
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIDBSystemBackup(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "production DB system without db_backup_config",
+			Content: `
+resource "oci_database_db_system" "db" {
+  db_home {
+    database {
+      db_name = "app"
+    }
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDBSystemBackupRule(),
+					Message: "OCI DB System 'oci_database_db_system' is tagged as production but does not have automatic backups enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "production DB system with automatic backups disabled",
+			Content: `
+resource "oci_database_db_system" "db" {
+  db_home {
+    database {
+      db_backup_config {
+        auto_backup_enabled = false
+      }
+    }
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDBSystemBackupRule(),
+					Message: "OCI DB System 'oci_database_db_system' is tagged as production but does not have automatic backups enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 31},
+						End:      hcl.Pos{Line: 6, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "production DB system with short recovery window",
+			Content: `
+resource "oci_database_db_system" "db" {
+  db_home {
+    database {
+      db_backup_config {
+        auto_backup_enabled     = true
+        recovery_window_in_days = 5
+      }
+    }
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDBSystemBackupRule(),
+					Message: "OCI DB System 'oci_database_db_system' is tagged as production but retains backups for 5 days, less than 7",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 35},
+						End:      hcl.Pos{Line: 7, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "production DB system with automatic backups",
+			Content: `
+resource "oci_database_db_system" "db" {
+  db_home {
+    database {
+      db_backup_config {
+        auto_backup_enabled     = true
+        recovery_window_in_days = 30
+      }
+    }
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "untagged DB system without backups",
+			Content: `
+resource "oci_database_db_system" "db" {
+  db_home {
+    database {
+      db_name = "app"
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIDBSystemBackupRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
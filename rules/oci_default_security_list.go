@@ -0,0 +1,199 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/joelp172/tflint-ruleset-oci/rules/cidr"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIDefaultSecurityListRule checks if OCI subnets rely on the default security list and if it allows more than ICMP within the VCN
+type OCIDefaultSecurityListRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIDefaultSecurityListRule returns a new rule
+func NewOCIDefaultSecurityListRule() *OCIDefaultSecurityListRule {
+	return &OCIDefaultSecurityListRule{}
+}
+
+// Name returns the rule name
+func (r *OCIDefaultSecurityListRule) Name() string {
+	return "oci_default_security_list"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIDefaultSecurityListRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIDefaultSecurityListRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIDefaultSecurityListRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Network/Concepts/securitylists.htm#Default"
+}
+
+// Check checks if OCI subnets set security_list_ids and if default security lists only allow ICMP ingress from within the VCN
+func (r *OCIDefaultSecurityListRule) Check(runner tflint.Runner) error {
+	subnets, err := runner.GetResourceContent("oci_core_subnet", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "security_list_ids"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range subnets.Blocks {
+		addr := resource.Labels[0]
+
+		attr, exists := resource.Body.Attributes["security_list_ids"]
+		if !exists {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Subnet '%s' does not set security_list_ids and uses the VCN default security list", addr),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		if referencesDefaultSecurityList(attr.Expr) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Subnet '%s' uses the VCN default security list in security_list_ids", addr),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	vcns, err := vcnAddressSpaces(runner)
+	if err != nil {
+		return err
+	}
+
+	lists, err := runner.GetResourceContent("oci_core_default_security_list", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "manage_default_resource_id"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "ingress_security_rules",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "protocol"},
+						{Name: "source"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range lists.Blocks {
+		// Resolve the address space of the VCN owning the default security list, when declared in the module
+		var space []netip.Prefix
+		if attr, exists := resource.Body.Attributes["manage_default_resource_id"]; exists {
+			if names := referencedResourceNames(attr.Expr, "oci_core_vcn"); len(names) > 0 {
+				space = vcns[names[0]]
+			}
+		}
+
+		for _, rule := range resource.Body.Blocks {
+			r.checkIngressRule(runner, resource, rule, space)
+		}
+	}
+	return nil
+}
+
+// checkIngressRule checks if a default security list ingress rule only allows ICMP from within the VCN
+func (r *OCIDefaultSecurityListRule) checkIngressRule(runner tflint.Runner, resource *hclext.Block, rule *hclext.Block, space []netip.Prefix) {
+	addr := resource.Labels[0]
+
+	protocolAttr, exists := rule.Body.Attributes["protocol"]
+	if !exists {
+		return
+	}
+
+	var protocol string
+	if err := runner.EvaluateExpr(protocolAttr.Expr, &protocol, nil); err != nil {
+		// Skip if we can't evaluate the protocol
+		return
+	}
+
+	if protocol != "1" && protocol != "58" { // 1 is ICMP, 58 is ICMPv6
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Default Security List '%s' allows ingress for protocol '%s', only ICMP from within the VCN should be allowed", addr, protocol),
+			protocolAttr.Expr.Range(),
+		)
+		return
+	}
+
+	// ICMP is only acceptable from within the VCN. When its address space is unknown, only private sources
+	// may lie within it.
+	sourceAttr, exists := rule.Body.Attributes["source"]
+	if !exists {
+		return
+	}
+
+	var source string
+	if err := runner.EvaluateExpr(sourceAttr.Expr, &source, nil); err != nil {
+		// Skip if we can't evaluate the source (likely a reference to another resource)
+		return
+	}
+
+	prefix, err := cidr.Parse(source)
+	if err != nil {
+		return
+	}
+
+	if len(space) == 0 {
+		if !cidr.IsPrivate(prefix) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Default Security List '%s' allows ICMP ingress from %s, only ICMP from within the VCN should be allowed", addr, source),
+				sourceAttr.Expr.Range(),
+			)
+		}
+		return
+	}
+
+	for _, block := range space {
+		if cidr.Contains(block, prefix) {
+			return
+		}
+	}
+
+	runner.EmitIssue(
+		r,
+		fmt.Sprintf("OCI Default Security List '%s' allows ICMP ingress from %s which is outside the VCN (%s)", addr, source, formatPrefixes(space)),
+		sourceAttr.Expr.Range(),
+	)
+}
+
+// referencesDefaultSecurityList reports whether the expression references a VCN's default_security_list_id or an
+// oci_core_default_security_list
+func referencesDefaultSecurityList(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		switch traversal.RootName() {
+		case "oci_core_default_security_list":
+			return true
+		case "oci_core_vcn":
+			for _, step := range traversal[1:] {
+				if attr, ok := step.(hcl.TraverseAttr); ok && attr.Name == "default_security_list_id" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,222 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIDefaultSecurityList(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "subnet without security_list_ids",
+			Content: `
+resource "oci_core_subnet" "app" {
+  cidr_block = "10.0.1.0/24"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDefaultSecurityListRule(),
+					Message: "OCI Subnet 'oci_core_subnet' does not set security_list_ids and uses the VCN default security list",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "subnet with security_list_ids",
+			Content: `
+resource "oci_core_subnet" "app" {
+  cidr_block        = "10.0.1.0/24"
+  security_list_ids = [oci_core_security_list.app.id]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "subnets referencing the default security list",
+			Content: `
+resource "oci_core_subnet" "app" {
+  security_list_ids = [oci_core_vcn.main.default_security_list_id]
+}
+
+resource "oci_core_subnet" "db" {
+  security_list_ids = [oci_core_security_list.db.id, oci_core_default_security_list.main.id]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDefaultSecurityListRule(),
+					Message: "OCI Subnet 'oci_core_subnet' uses the VCN default security list in security_list_ids",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 23},
+						End:      hcl.Pos{Line: 3, Column: 67},
+					},
+				},
+				{
+					Rule:    NewOCIDefaultSecurityListRule(),
+					Message: "OCI Subnet 'oci_core_subnet' uses the VCN default security list in security_list_ids",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 7, Column: 93},
+					},
+				},
+			},
+		},
+		{
+			Name: "default security list allowing SSH",
+			Content: `
+resource "oci_core_vcn" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "oci_core_default_security_list" "main" {
+  manage_default_resource_id = oci_core_vcn.main.default_security_list_id
+
+  ingress_security_rules {
+    protocol = "6"
+    source   = "0.0.0.0/0"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDefaultSecurityListRule(),
+					Message: "OCI Default Security List 'oci_core_default_security_list' allows ingress for protocol '6', only ICMP from within the VCN should be allowed",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 10, Column: 16},
+						End:      hcl.Pos{Line: 10, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "default security list allowing ICMP from the internet",
+			Content: `
+resource "oci_core_vcn" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "oci_core_default_security_list" "main" {
+  manage_default_resource_id = oci_core_vcn.main.default_security_list_id
+
+  ingress_security_rules {
+    protocol = "1"
+    source   = "0.0.0.0/0"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDefaultSecurityListRule(),
+					Message: "OCI Default Security List 'oci_core_default_security_list' allows ICMP ingress from 0.0.0.0/0 which is outside the VCN (10.0.0.0/16)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 11, Column: 16},
+						End:      hcl.Pos{Line: 11, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "default security list of an unknown VCN allowing ICMP from the internet",
+			Content: `
+variable "id" {}
+
+resource "oci_core_default_security_list" "main" {
+  manage_default_resource_id = var.id
+
+  ingress_security_rules {
+    protocol = "1"
+    source   = "0.0.0.0/0"
+  }
+
+  ingress_security_rules {
+    protocol = "1"
+    source   = "10.0.0.0/16"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDefaultSecurityListRule(),
+					Message: "OCI Default Security List 'oci_core_default_security_list' allows ICMP ingress from 0.0.0.0/0, only ICMP from within the VCN should be allowed",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 16},
+						End:      hcl.Pos{Line: 9, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "default security list of an unknown VCN allowing ICMP from half the internet",
+			Content: `
+variable "id" {}
+
+resource "oci_core_default_security_list" "main" {
+  manage_default_resource_id = var.id
+
+  ingress_security_rules {
+    protocol = "1"
+    source   = "0.0.0.0/1"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIDefaultSecurityListRule(),
+					Message: "OCI Default Security List 'oci_core_default_security_list' allows ICMP ingress from 0.0.0.0/1, only ICMP from within the VCN should be allowed",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 16},
+						End:      hcl.Pos{Line: 9, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			Name: "default security list allowing ICMP within the VCN",
+			Content: `
+resource "oci_core_vcn" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "oci_core_default_security_list" "main" {
+  manage_default_resource_id = oci_core_vcn.main.default_security_list_id
+
+  ingress_security_rules {
+    protocol = "1"
+    source   = "10.0.0.0/16"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "default security list without ingress rules",
+			Content: `
+resource "oci_core_default_security_list" "main" {
+  manage_default_resource_id = oci_core_vcn.main.default_security_list_id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIDefaultSecurityListRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
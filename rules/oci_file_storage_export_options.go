@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIFileStorageExportOptionsRule checks if OCI File Storage export options restrict clients and root access
+type OCIFileStorageExportOptionsRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIFileStorageExportOptionsRule returns a new rule
+func NewOCIFileStorageExportOptionsRule() *OCIFileStorageExportOptionsRule {
+	return &OCIFileStorageExportOptionsRule{}
+}
+
+// Name returns the rule name
+func (r *OCIFileStorageExportOptionsRule) Name() string {
+	return "oci_file_storage_export_options"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIFileStorageExportOptionsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIFileStorageExportOptionsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIFileStorageExportOptionsRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/File/Tasks/exportoptions.htm"
+}
+
+// Check checks if OCI File Storage export options restrict the source, require privileged ports and squash root
+func (r *OCIFileStorageExportOptionsRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_file_storage_export", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "export_options",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "source"},
+						{Name: "access"},
+						{Name: "identity_squash"},
+						{Name: "require_privileged_source_port"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		for _, opts := range resource.Body.Blocks {
+			// Check source
+			if sourceAttr, exists := opts.Body.Attributes["source"]; exists {
+				var source string
+				err := runner.EvaluateExpr(sourceAttr.Expr, &source, nil)
+				if err == nil && isUnrestrictedCIDR(source) {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("OCI File Storage Export '%s' allows access from any source", addr),
+						sourceAttr.Expr.Range(),
+					)
+				}
+			}
+
+			// Check privileged source port, which defaults to false
+			portAttr, exists := opts.Body.Attributes["require_privileged_source_port"]
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI File Storage Export '%s' does not require a privileged source port", addr),
+					resource.DefRange,
+				)
+			} else if required, ok := evaluateBool(runner, portAttr.Expr); ok && !required {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI File Storage Export '%s' does not require a privileged source port", addr),
+					portAttr.Expr.Range(),
+				)
+			}
+
+			// Check identity squash on writable exports, access defaults to READ_WRITE
+			access := "READ_WRITE"
+			if accessAttr, exists := opts.Body.Attributes["access"]; exists {
+				err := runner.EvaluateExpr(accessAttr.Expr, &access, nil)
+				if err != nil {
+					// Skip if we can't evaluate the access, the export may be read-only
+					continue
+				}
+			}
+			if access != "READ_WRITE" {
+				continue
+			}
+
+			squashAttr, exists := opts.Body.Attributes["identity_squash"]
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI File Storage Export '%s' allows root access without identity squash", addr),
+					resource.DefRange,
+				)
+				continue
+			}
+
+			var squash string
+			err := runner.EvaluateExpr(squashAttr.Expr, &squash, nil)
+			if err != nil {
+				// Skip if we can't evaluate the identity squash (likely a variable or reference)
+				continue
+			}
+
+			if squash == "NONE" {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI File Storage Export '%s' allows root access without identity squash", addr),
+					squashAttr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIFileStorageExportOptions(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "world-writable export with root access",
+			Content: `
+resource "oci_file_storage_export" "share" {
+  export_options {
+    source          = "0.0.0.0/0"
+    access          = "READ_WRITE"
+    identity_squash = "NONE"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIFileStorageExportOptionsRule(),
+					Message: "OCI File Storage Export 'oci_file_storage_export' allows access from any source",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+				{
+					Rule:    NewOCIFileStorageExportOptionsRule(),
+					Message: "OCI File Storage Export 'oci_file_storage_export' does not require a privileged source port",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 43},
+					},
+				},
+				{
+					Rule:    NewOCIFileStorageExportOptionsRule(),
+					Message: "OCI File Storage Export 'oci_file_storage_export' allows root access without identity squash",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 23},
+						End:      hcl.Pos{Line: 6, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "restricted export",
+			Content: `
+resource "oci_file_storage_export" "share" {
+  export_options {
+    source                         = "10.0.1.0/24"
+    access                         = "READ_WRITE"
+    identity_squash                = "ROOT"
+    require_privileged_source_port = true
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "read-only export without identity squash",
+			Content: `
+resource "oci_file_storage_export" "share" {
+  export_options {
+    source                         = "10.0.1.0/24"
+    access                         = "READ_ONLY"
+    require_privileged_source_port = false
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIFileStorageExportOptionsRule(),
+					Message: "OCI File Storage Export 'oci_file_storage_export' does not require a privileged source port",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 38},
+						End:      hcl.Pos{Line: 6, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "identity squash defaults to NONE",
+			Content: `
+resource "oci_file_storage_export" "share" {
+  export_options {
+    source                         = "10.0.1.0/24"
+    require_privileged_source_port = true
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIFileStorageExportOptionsRule(),
+					Message: "OCI File Storage Export 'oci_file_storage_export' allows root access without identity squash",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "options from variables without defaults",
+			Content: `
+variable "access" {}
+variable "privileged_port" {}
+variable "squash" {}
+
+resource "oci_file_storage_export" "share" {
+  export_options {
+    source                         = "10.0.1.0/24"
+    access                         = var.access
+    require_privileged_source_port = var.privileged_port
+    identity_squash                = "ROOT"
+  }
+}
+
+resource "oci_file_storage_export" "data" {
+  export_options {
+    source                         = "10.0.1.0/24"
+    require_privileged_source_port = true
+    identity_squash                = var.squash
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIFileStorageExportOptionsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIFileStorageFileSystemCustomerManagedKeyRule checks if OCI File Storage file systems are encrypted with a customer-managed key
+type OCIFileStorageFileSystemCustomerManagedKeyRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIFileStorageFileSystemCustomerManagedKeyRule returns a new rule
+func NewOCIFileStorageFileSystemCustomerManagedKeyRule() *OCIFileStorageFileSystemCustomerManagedKeyRule {
+	return &OCIFileStorageFileSystemCustomerManagedKeyRule{}
+}
+
+// Name returns the rule name
+func (r *OCIFileStorageFileSystemCustomerManagedKeyRule) Name() string {
+	return "oci_file_storage_file_system_customer_managed_key"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIFileStorageFileSystemCustomerManagedKeyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIFileStorageFileSystemCustomerManagedKeyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIFileStorageFileSystemCustomerManagedKeyRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/File/Tasks/encrypt-file-system.htm"
+}
+
+// Check checks if OCI File Storage file systems set a kms_key_id
+func (r *OCIFileStorageFileSystemCustomerManagedKeyRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_file_storage_file_system", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "kms_key_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+		message := fmt.Sprintf("OCI File Storage File System '%s' is not encrypted with a customer-managed key", addr)
+
+		checkKMSAttribute(runner, r, resource.Body, "kms_key_id", message, resource.DefRange)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIFileStorageFileSystemCustomerManagedKey(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "file system without kms_key_id",
+			Content: `
+resource "oci_file_storage_file_system" "fs" {
+  availability_domain = "ad1"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIFileStorageFileSystemCustomerManagedKeyRule(),
+					Message: "OCI File Storage File System 'oci_file_storage_file_system' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "file system with empty kms_key_id",
+			Content: `
+resource "oci_file_storage_file_system" "fs" {
+  kms_key_id = ""
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIFileStorageFileSystemCustomerManagedKeyRule(),
+					Message: "OCI File Storage File System 'oci_file_storage_file_system' is not encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "file system with kms_key_id",
+			Content: `
+resource "oci_file_storage_file_system" "fs" {
+  kms_key_id = oci_kms_key.fss.id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIFileStorageFileSystemCustomerManagedKeyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
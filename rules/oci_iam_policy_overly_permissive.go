@@ -0,0 +1,165 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/joelp172/tflint-ruleset-oci/rules/policy"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIIAMPolicyOverlyPermissiveRule checks if OCI IAM policy statements grant overly broad access
+type OCIIAMPolicyOverlyPermissiveRule struct {
+	tflint.DefaultRule
+}
+
+// ociIAMPolicyOverlyPermissiveRuleConfig is the rule configuration
+type ociIAMPolicyOverlyPermissiveRuleConfig struct {
+	// AdminGroups are the groups allowed to manage all resources, by name or OCID. Names without an identity
+	// domain (e.g. "Administrators") are in the Default domain, other domains are written "'Domain'/'Group'".
+	AdminGroups []string `hclext:"admin_groups,optional"`
+}
+
+// NewOCIIAMPolicyOverlyPermissiveRule returns a new rule
+func NewOCIIAMPolicyOverlyPermissiveRule() *OCIIAMPolicyOverlyPermissiveRule {
+	return &OCIIAMPolicyOverlyPermissiveRule{}
+}
+
+// Name returns the rule name
+func (r *OCIIAMPolicyOverlyPermissiveRule) Name() string {
+	return "oci_iam_policy_overly_permissive"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIIAMPolicyOverlyPermissiveRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIIAMPolicyOverlyPermissiveRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIIAMPolicyOverlyPermissiveRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Identity/policiescommon/commonpolicies.htm"
+}
+
+// Check checks if OCI IAM policy statements grant tenancy administration outside the admin groups, allow
+// any user without conditions, or grant access to all resources without conditions
+func (r *OCIIAMPolicyOverlyPermissiveRule) Check(runner tflint.Runner) error {
+	config := &ociIAMPolicyOverlyPermissiveRuleConfig{
+		AdminGroups: []string{"Administrators"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent("oci_identity_policy", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "statements"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		attr, exists := resource.Body.Attributes["statements"]
+		if !exists {
+			continue
+		}
+
+		for _, statement := range policyStatements(runner, attr) {
+			parsed, err := policy.Parse(statement.text)
+			if err != nil || parsed.Action != policy.ActionAllow {
+				// Syntax errors are reported by oci_iam_policy_syntax, Endorse, Admit and Define statements are skipped
+				continue
+			}
+
+			var nonAdmins []string
+			for _, subject := range parsed.Subjects {
+				if !isAdminSubject(subject, config.AdminGroups) {
+					nonAdmins = append(nonAdmins, describePolicySubject(subject))
+				}
+			}
+
+			tenancyAdmin := parsed.Verb == "manage" && parsed.ResourceType == "all-resources" && parsed.Location.Kind == policy.LocationTenancy
+			if tenancyAdmin && len(nonAdmins) > 0 {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI IAM Policy '%s' grants manage all-resources in tenancy to %s outside the administrator groups", addr, strings.Join(nonAdmins, ", ")),
+					statement.rng,
+				)
+			}
+
+			if parsed.Where != nil {
+				continue
+			}
+
+			for _, subject := range parsed.Subjects {
+				if subject.Kind == policy.SubjectAnyUser {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("OCI IAM Policy '%s' allows any-user without a where clause", addr),
+						statement.rng,
+					)
+				}
+			}
+
+			if !tenancyAdmin && parsed.ResourceType == "all-resources" && len(nonAdmins) > 0 {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI IAM Policy '%s' grants %s all-resources to %s without a where clause", addr, parsed.Verb, strings.Join(nonAdmins, ", ")),
+					statement.rng,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// isAdminSubject reports whether the subject is one of the admin groups. Groups given by OCID are only admins
+// when their OCID is listed.
+func isAdminSubject(subject policy.Subject, adminGroups []string) bool {
+	if subject.Kind != policy.SubjectGroup {
+		return false
+	}
+
+	if subject.ByID {
+		for _, group := range adminGroups {
+			if strings.EqualFold(subject.Name, group) {
+				return true
+			}
+		}
+		return false
+	}
+
+	domain, name := policyGroupName(subject.Name)
+	for _, group := range adminGroups {
+		adminDomain, adminName := policyGroupName(group)
+		if strings.EqualFold(domain, adminDomain) && strings.EqualFold(name, adminName) {
+			return true
+		}
+	}
+	return false
+}
+
+// policyGroupName splits a group name into its identity domain and name without quotes, e.g. "Default" and
+// "Administrators" for "'Default'/'Administrators'". Names without a domain are in the Default domain.
+func policyGroupName(group string) (string, string) {
+	domain, name, found := strings.Cut(group, "/")
+	if !found {
+		domain, name = "Default", group
+	}
+	return strings.Trim(domain, `'"`), strings.Trim(name, `'"`)
+}
+
+// describePolicySubject formats a subject as written in a policy, e.g. "group NetworkAdmins"
+func describePolicySubject(subject policy.Subject) string {
+	if subject.Name == "" {
+		return subject.Kind
+	}
+	return subject.Kind + " " + subject.Name
+}
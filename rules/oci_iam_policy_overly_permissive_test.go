@@ -0,0 +1,263 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIIAMPolicyOverlyPermissiveRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Tenancy administration for the Administrators group",
+			Content: `
+resource "oci_identity_policy" "admins" {
+  statements = [
+    "Allow group Administrators to manage all-resources in tenancy",
+    "Allow group 'Default'/'Administrators' to manage all-resources in tenancy",
+  ]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Tenancy administration for another group",
+			Content: `
+resource "oci_identity_policy" "ops" {
+  statements = [
+    "Allow group NetworkAdmins to manage virtual-network-family in tenancy",
+    "Allow group Ops to manage all-resources in tenancy",
+  ]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' grants manage all-resources in tenancy to group Ops outside the administrator groups",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 5},
+						End:      hcl.Pos{Line: 5, Column: 57},
+					},
+				},
+			},
+		},
+		{
+			Name: "Tenancy administration for a dynamic group with conditions",
+			Content: `
+resource "oci_identity_policy" "automation" {
+  statements = ["Allow dynamic-group Automation to manage all-resources in tenancy where request.region = 'iad'"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' grants manage all-resources in tenancy to dynamic-group Automation outside the administrator groups",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 113},
+					},
+				},
+			},
+		},
+		{
+			Name: "Tenancy administration limited to weekdays",
+			Content: `
+resource "oci_identity_policy" "devs" {
+  statements = ["Allow group Devs to manage all-resources in tenancy where request.utc-timestamp.day-of-week in ('monday', 'tuesday')"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' grants manage all-resources in tenancy to group Devs outside the administrator groups",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 135},
+					},
+				},
+			},
+		},
+		{
+			Name: "Any user without conditions",
+			Content: `
+resource "oci_identity_policy" "public" {
+  statements = [
+    "Allow any-user to read objects in compartment Public",
+    "Allow any-user to use functions-family in compartment Apps where request.principal.type = 'ApiGateway'",
+  ]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' allows any-user without a where clause",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 59},
+					},
+				},
+			},
+		},
+		{
+			Name: "All resources in a compartment without conditions",
+			Content: `
+resource "oci_identity_policy" "apps" {
+  statements = [
+    "Allow group AppDevs to use all-resources in compartment Apps",
+    "Allow group AppDevs to read all-resources in compartment Apps where request.permission != 'SECRET_BUNDLE_READ'",
+  ]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' grants use all-resources to group AppDevs without a where clause",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 67},
+					},
+				},
+			},
+		},
+		{
+			Name: "Compartment administrators",
+			Content: `
+resource "oci_identity_policy" "apps" {
+  statements = ["Allow group Administrators to manage all-resources in compartment Apps"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Statements from a variable",
+			Content: `
+variable "statements" {
+  default = ["Allow group Ops to manage all-resources in tenancy"]
+}
+
+resource "oci_identity_policy" "ops" {
+  statements = var.statements
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' grants manage all-resources in tenancy to group Ops outside the administrator groups",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 16},
+						End:      hcl.Pos{Line: 7, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "Unparsable and cross-tenancy statements",
+			Content: `
+resource "oci_identity_policy" "cross_tenancy" {
+  statements = [
+    "Allow group Ops to mange all-resources in tenancy",
+    "Endorse group Ops to manage all-resources in any-tenancy",
+  ]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Configured admin groups",
+			Content: `
+resource "oci_identity_policy" "ops" {
+  statements = ["Allow group CloudOps to manage all-resources in tenancy"]
+}`,
+			Config: `
+rule "oci_iam_policy_overly_permissive" {
+  enabled      = true
+  admin_groups = ["Administrators", "CloudOps"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Administrators group of another identity domain",
+			Content: `
+resource "oci_identity_policy" "ops" {
+  statements = ["Allow group 'OtherDomain'/'Administrators' to manage all-resources in tenancy"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' grants manage all-resources in tenancy to group 'OtherDomain'/'Administrators' outside the administrator groups",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 96},
+					},
+				},
+			},
+		},
+		{
+			Name: "Group given by OCID",
+			Content: `
+resource "oci_identity_policy" "ops" {
+  statements = ["Allow group id ocid1.group.oc1..aaa to manage all-resources in tenancy"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' grants manage all-resources in tenancy to group ocid1.group.oc1..aaa outside the administrator groups",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 89},
+					},
+				},
+			},
+		},
+		{
+			Name: "Configured admin groups by domain and OCID",
+			Content: `
+resource "oci_identity_policy" "ops" {
+  statements = [
+    "Allow group 'Ops'/'CloudAdmins' to manage all-resources in tenancy",
+    "Allow group id ocid1.group.oc1..aaa to manage all-resources in tenancy",
+    "Allow group CloudAdmins to manage all-resources in tenancy",
+  ]
+}`,
+			Config: `
+rule "oci_iam_policy_overly_permissive" {
+  enabled      = true
+  admin_groups = ["'Ops'/'CloudAdmins'", "ocid1.group.oc1..aaa"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicyOverlyPermissiveRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' grants manage all-resources in tenancy to group CloudAdmins outside the administrator groups",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 65},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOCIIAMPolicyOverlyPermissiveRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
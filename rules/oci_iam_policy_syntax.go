@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/joelp172/tflint-ruleset-oci/rules/policy"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIIAMPolicySyntaxRule checks if OCI IAM policy statements are valid policy language
+type OCIIAMPolicySyntaxRule struct {
+	tflint.DefaultRule
+}
+
+// ociIAMPolicySyntaxRuleConfig is the rule configuration
+type ociIAMPolicySyntaxRuleConfig struct {
+	// AdditionalResourceTypes are accepted on top of the resource types known to the plugin, for services
+	// missing from its catalog
+	AdditionalResourceTypes []string `hclext:"additional_resource_types,optional"`
+	// ReportUnknownResourceTypes reports every resource type missing from the catalog, not only likely misspellings
+	ReportUnknownResourceTypes bool `hclext:"report_unknown_resource_types,optional"`
+}
+
+// NewOCIIAMPolicySyntaxRule returns a new rule
+func NewOCIIAMPolicySyntaxRule() *OCIIAMPolicySyntaxRule {
+	return &OCIIAMPolicySyntaxRule{}
+}
+
+// Name returns the rule name
+func (r *OCIIAMPolicySyntaxRule) Name() string {
+	return "oci_iam_policy_syntax"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIIAMPolicySyntaxRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIIAMPolicySyntaxRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIIAMPolicySyntaxRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Identity/policysyntax/policysyntax.htm"
+}
+
+// Check checks if OCI IAM policy statements parse, use known resource types and refer to compartments and groups correctly by name or OCID
+func (r *OCIIAMPolicySyntaxRule) Check(runner tflint.Runner) error {
+	config := &ociIAMPolicySyntaxRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent("oci_identity_policy", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "statements"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		attr, exists := resource.Body.Attributes["statements"]
+		if !exists {
+			continue
+		}
+
+		for _, statement := range policyStatements(runner, attr) {
+			for _, err := range policy.Validate(statement.text, policy.ValidateOptions{
+				AdditionalResourceTypes:    config.AdditionalResourceTypes,
+				ReportUnknownResourceTypes: config.ReportUnknownResourceTypes,
+			}) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI IAM Policy '%s' has an invalid statement: %s", addr, err),
+					statement.rng,
+				)
+			}
+		}
+	}
+	return nil
+}
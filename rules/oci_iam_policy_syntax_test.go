@@ -0,0 +1,211 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIIAMPolicySyntaxRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Valid statements",
+			Content: `
+resource "oci_identity_policy" "network" {
+  statements = [
+    "Allow group NetworkAdmins to manage virtual-network-family in compartment Networks",
+    "Allow dynamic-group id ocid1.dynamicgroup.oc1..aaa to read buckets in compartment id ocid1.compartment.oc1..bbb where target.bucket.name = 'logs'",
+    "Allow group Ops to {INSTANCE_READ, INSTANCE_INSPECT} in tenancy",
+    "Allow group Ops to use instances in tenancy where request.utc-timestamp.day-of-week in ('monday', 'tuesday')",
+  ]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Unknown verb",
+			Content: `
+resource "oci_identity_policy" "network" {
+  statements = [
+    "Allow group NetworkAdmins to manage virtual-network-family in compartment Networks",
+    "Allow group NetworkAdmins to admin load-balancers in compartment Networks",
+  ]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicySyntaxRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' has an invalid statement: unknown verb 'admin', expected inspect, read, use or manage",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 5},
+						End:      hcl.Pos{Line: 5, Column: 80},
+					},
+				},
+			},
+		},
+		{
+			Name: "Misspelled resource type",
+			Content: `
+resource "oci_identity_policy" "network" {
+  statements = ["Allow group NetworkAdmins to manage virtual-network-familiy in compartment Networks"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicySyntaxRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' has an invalid statement: unknown resource type 'virtual-network-familiy', did you mean 'virtual-network-family'?",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 102},
+					},
+				},
+			},
+		},
+		{
+			Name: "Uncatalogued resource types",
+			Content: `
+resource "oci_identity_policy" "platform" {
+  statements = [
+    "Allow group Ops to use cloud-shell in tenancy",
+    "Allow group Ops to manage orm-family in tenancy",
+    "Allow group Ops to manage compute-container-family in tenancy",
+    "Allow group Ops to manage widgets in tenancy",
+  ]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Unknown resource types reported when requested",
+			Content: `
+resource "oci_identity_policy" "widgets" {
+  statements = [
+    "Allow group Ops to manage widgets in tenancy",
+    "Allow group Ops to manage sprockets in tenancy",
+  ]
+}`,
+			Config: `
+rule "oci_iam_policy_syntax" {
+  enabled                       = true
+  report_unknown_resource_types = true
+  additional_resource_types     = ["sprockets"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicySyntaxRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' has an invalid statement: unknown resource type 'widgets'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "Bad where expression",
+			Content: `
+resource "oci_identity_policy" "objects" {
+  statements = ["Allow group Ops to read objects in tenancy where bucket.name = 'logs'"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicySyntaxRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' has an invalid statement: unknown condition variable 'bucket.name', variables start with 'request.' or 'target.'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 88},
+					},
+				},
+			},
+		},
+		{
+			Name: "Compartment OCID without id",
+			Content: `
+resource "oci_identity_policy" "objects" {
+  statements = ["Allow group Ops to read objects in compartment ocid1.compartment.oc1..aaa"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicySyntaxRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' has an invalid statement: 'ocid1.compartment.oc1..aaa' is an OCID, use 'compartment id ocid1.compartment.oc1..aaa' to refer to a compartment by OCID",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 92},
+					},
+				},
+			},
+		},
+		{
+			Name: "Compartment name with id",
+			Content: `
+resource "oci_identity_policy" "objects" {
+  statements = ["Allow group Ops to read objects in compartment id Apps"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicySyntaxRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' has an invalid statement: 'compartment id' expects an OCID but found 'Apps', use 'compartment Apps' to refer to it by name",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 73},
+					},
+				},
+			},
+		},
+		{
+			Name: "Statements from a variable",
+			Content: `
+variable "statements" {
+  default = ["Allow group Ops read objects in tenancy"]
+}
+
+resource "oci_identity_policy" "objects" {
+  statements = var.statements
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIIAMPolicySyntaxRule(),
+					Message: "OCI IAM Policy 'oci_identity_policy' has an invalid statement: expected 'to' but found 'read'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 16},
+						End:      hcl.Pos{Line: 7, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "Statement that can't be evaluated",
+			Content: `
+resource "oci_identity_policy" "objects" {
+  statements = ["Allow group ${oci_identity_group.ops.name} to read objects in tenancy"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIIAMPolicySyntaxRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCILoadBalancerBackendSetHealthCheckerRule checks if OCI Load Balancer backend sets have a health checker probing a specific HTTP path
+type OCILoadBalancerBackendSetHealthCheckerRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCILoadBalancerBackendSetHealthCheckerRule returns a new rule
+func NewOCILoadBalancerBackendSetHealthCheckerRule() *OCILoadBalancerBackendSetHealthCheckerRule {
+	return &OCILoadBalancerBackendSetHealthCheckerRule{}
+}
+
+// Name returns the rule name
+func (r *OCILoadBalancerBackendSetHealthCheckerRule) Name() string {
+	return "oci_load_balancer_backend_set_health_checker"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCILoadBalancerBackendSetHealthCheckerRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCILoadBalancerBackendSetHealthCheckerRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCILoadBalancerBackendSetHealthCheckerRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Balance/Tasks/editinghealthcheck.htm"
+}
+
+// Check checks if OCI Load Balancer backend sets have a health_checker, and if HTTP health checks set a url_path other than "/"
+func (r *OCILoadBalancerBackendSetHealthCheckerRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_load_balancer_backend_set", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "health_checker",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "protocol"},
+						{Name: "url_path"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		// Check if health_checker block exists
+		if len(resource.Body.Blocks) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Load Balancer Backend Set '%s' does not have a health_checker", addr),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		for _, healthChecker := range resource.Body.Blocks {
+			protocolAttr, exists := healthChecker.Body.Attributes["protocol"]
+			if !exists {
+				continue
+			}
+
+			var protocol string
+			err := runner.EvaluateExpr(protocolAttr.Expr, &protocol, nil)
+			if err != nil || protocol != "HTTP" {
+				continue
+			}
+
+			pathAttr, exists := healthChecker.Body.Attributes["url_path"]
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer Backend Set '%s' HTTP health_checker uses the default url_path", addr),
+					resource.DefRange,
+				)
+				continue
+			}
+
+			var path string
+			err = runner.EvaluateExpr(pathAttr.Expr, &path, nil)
+			if err != nil {
+				// Skip if we can't evaluate the path
+				continue
+			}
+
+			if path == "" || path == "/" {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer Backend Set '%s' HTTP health_checker uses the default url_path", addr),
+					pathAttr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
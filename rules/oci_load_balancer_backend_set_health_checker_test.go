@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCILoadBalancerBackendSetHealthChecker(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "backend set without health_checker",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  policy = "ROUND_ROBIN"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerBackendSetHealthCheckerRule(),
+					Message: "OCI Load Balancer Backend Set 'oci_load_balancer_backend_set' does not have a health_checker",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "HTTP health_checker without url_path",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  health_checker {
+    protocol = "HTTP"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerBackendSetHealthCheckerRule(),
+					Message: "OCI Load Balancer Backend Set 'oci_load_balancer_backend_set' HTTP health_checker uses the default url_path",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "HTTP health_checker on root path",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  health_checker {
+    protocol = "HTTP"
+    url_path = "/"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerBackendSetHealthCheckerRule(),
+					Message: "OCI Load Balancer Backend Set 'oci_load_balancer_backend_set' HTTP health_checker uses the default url_path",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 16},
+						End:      hcl.Pos{Line: 5, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "HTTP health_checker on health endpoint",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  health_checker {
+    protocol = "HTTP"
+    url_path = "/healthz"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "TCP health_checker",
+			Content: `
+resource "oci_load_balancer_backend_set" "db" {
+  health_checker {
+    protocol = "TCP"
+    port     = 5432
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCILoadBalancerBackendSetHealthCheckerRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCILoadBalancerBackendSetSessionPersistenceRule checks if OCI Load Balancer session persistence cookies are secure and HTTP only
+type OCILoadBalancerBackendSetSessionPersistenceRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCILoadBalancerBackendSetSessionPersistenceRule returns a new rule
+func NewOCILoadBalancerBackendSetSessionPersistenceRule() *OCILoadBalancerBackendSetSessionPersistenceRule {
+	return &OCILoadBalancerBackendSetSessionPersistenceRule{}
+}
+
+// Name returns the rule name
+func (r *OCILoadBalancerBackendSetSessionPersistenceRule) Name() string {
+	return "oci_load_balancer_backend_set_session_persistence"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCILoadBalancerBackendSetSessionPersistenceRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCILoadBalancerBackendSetSessionPersistenceRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCILoadBalancerBackendSetSessionPersistenceRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Balance/Reference/sessionpersistence.htm"
+}
+
+// Check checks if lb_cookie_session_persistence_configuration sets the Secure and HttpOnly cookie attributes
+func (r *OCILoadBalancerBackendSetSessionPersistenceRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_load_balancer_backend_set", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "lb_cookie_session_persistence_configuration",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "is_secure"},
+						{Name: "is_http_only"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		for _, persistence := range resource.Body.Blocks {
+			// is_secure defaults to false
+			secureAttr, exists := persistence.Body.Attributes["is_secure"]
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer Backend Set '%s' session persistence cookie is not marked secure", addr),
+					resource.DefRange,
+				)
+			} else {
+				secure, ok := evaluateBool(runner, secureAttr.Expr)
+				if ok && !secure {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("OCI Load Balancer Backend Set '%s' session persistence cookie is not marked secure", addr),
+						secureAttr.Expr.Range(),
+					)
+				}
+			}
+
+			// is_http_only defaults to true, so only an explicit false is flagged
+			httpOnlyAttr, exists := persistence.Body.Attributes["is_http_only"]
+			if !exists {
+				continue
+			}
+
+			httpOnly, ok := evaluateBool(runner, httpOnlyAttr.Expr)
+			if ok && !httpOnly {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer Backend Set '%s' session persistence cookie is not marked HTTP only", addr),
+					httpOnlyAttr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
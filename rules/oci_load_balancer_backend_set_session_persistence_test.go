@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCILoadBalancerBackendSetSessionPersistence(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "cookie without is_secure",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  lb_cookie_session_persistence_configuration {
+    cookie_name = "lb"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerBackendSetSessionPersistenceRule(),
+					Message: "OCI Load Balancer Backend Set 'oci_load_balancer_backend_set' session persistence cookie is not marked secure",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "cookie not secure and not HTTP only",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  lb_cookie_session_persistence_configuration {
+    is_secure    = false
+    is_http_only = false
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerBackendSetSessionPersistenceRule(),
+					Message: "OCI Load Balancer Backend Set 'oci_load_balancer_backend_set' session persistence cookie is not marked secure",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 20},
+						End:      hcl.Pos{Line: 4, Column: 25},
+					},
+				},
+				{
+					Rule:    NewOCILoadBalancerBackendSetSessionPersistenceRule(),
+					Message: "OCI Load Balancer Backend Set 'oci_load_balancer_backend_set' session persistence cookie is not marked HTTP only",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 20},
+						End:      hcl.Pos{Line: 5, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "secure cookie",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  lb_cookie_session_persistence_configuration {
+    is_secure = true
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "no session persistence",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  policy = "ROUND_ROBIN"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCILoadBalancerBackendSetSessionPersistenceRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCILoadBalancerBackendSetSSLVerifyRule checks if OCI Load Balancer backend sets using TLS verify the backend peer certificate
+type OCILoadBalancerBackendSetSSLVerifyRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCILoadBalancerBackendSetSSLVerifyRule returns a new rule
+func NewOCILoadBalancerBackendSetSSLVerifyRule() *OCILoadBalancerBackendSetSSLVerifyRule {
+	return &OCILoadBalancerBackendSetSSLVerifyRule{}
+}
+
+// Name returns the rule name
+func (r *OCILoadBalancerBackendSetSSLVerifyRule) Name() string {
+	return "oci_load_balancer_backend_set_ssl_verify"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCILoadBalancerBackendSetSSLVerifyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCILoadBalancerBackendSetSSLVerifyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCILoadBalancerBackendSetSSLVerifyRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Balance/Tasks/managingcertificates.htm"
+}
+
+// Check checks if backend sets with an ssl_configuration enable verify_peer_certificate
+func (r *OCILoadBalancerBackendSetSSLVerifyRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_load_balancer_backend_set", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "ssl_configuration",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "verify_peer_certificate"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		for _, sslConfig := range resource.Body.Blocks {
+			attr, exists := sslConfig.Body.Attributes["verify_peer_certificate"]
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer Backend Set '%s' does not verify the backend peer certificate", addr),
+					resource.DefRange,
+				)
+				continue
+			}
+
+			verify, ok := evaluateBool(runner, attr.Expr)
+			if ok && !verify {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer Backend Set '%s' does not verify the backend peer certificate", addr),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCILoadBalancerBackendSetSSLVerify(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "HTTPS backend without verify_peer_certificate",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  ssl_configuration {
+    trusted_certificate_authority_ids = [oci_certificates_management_ca_bundle.internal.id]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerBackendSetSSLVerifyRule(),
+					Message: "OCI Load Balancer Backend Set 'oci_load_balancer_backend_set' does not verify the backend peer certificate",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "HTTPS backend with verify_peer_certificate disabled",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  ssl_configuration {
+    verify_peer_certificate = false
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerBackendSetSSLVerifyRule(),
+					Message: "OCI Load Balancer Backend Set 'oci_load_balancer_backend_set' does not verify the backend peer certificate",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "HTTPS backend with verify_peer_certificate",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  ssl_configuration {
+    verify_peer_certificate = true
+    verify_depth            = 3
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "HTTP backend",
+			Content: `
+resource "oci_load_balancer_backend_set" "web" {
+  policy = "ROUND_ROBIN"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "HTTPS backend with verify_peer_certificate from a variable without a default",
+			Content: `
+variable "verify" {}
+
+resource "oci_load_balancer_backend_set" "web" {
+  ssl_configuration {
+    verify_peer_certificate = var.verify
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCILoadBalancerBackendSetSSLVerifyRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
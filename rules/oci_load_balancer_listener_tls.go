@@ -0,0 +1,269 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCILoadBalancerListenerTLSRule checks if OCI Load Balancer listeners terminate TLS with modern protocols and an approved cipher suite
+type OCILoadBalancerListenerTLSRule struct {
+	tflint.DefaultRule
+}
+
+// ociLoadBalancerListenerTLSRuleConfig is the rule configuration
+type ociLoadBalancerListenerTLSRuleConfig struct {
+	ApprovedCipherSuites []string `hclext:"approved_cipher_suites,optional"`
+}
+
+// defaultApprovedCipherSuites are the predefined OCI cipher suites that only include forward secret AEAD TLS 1.2 and
+// TLS 1.3 ciphers. Suites such as oci-modern-ssl-cipher-suite-v1 and oci-tls-12-13-wider-ssl-cipher-suite-v1 also
+// include CBC or static RSA ciphers, see https://docs.oracle.com/en-us/iaas/Content/Balance/Tasks/managingciphersuites.htm
+var defaultApprovedCipherSuites = []string{
+	"oci-default-http2-ssl-cipher-suite-v1",
+	"oci-default-http2-tls-13-ssl-cipher-suite-v1",
+	"oci-default-http2-tls-12-13-ssl-cipher-suite-v1",
+	"oci-tls-13-recommended-ssl-cipher-suite-v1",
+}
+
+// allowedTLSProtocols are the TLS versions a listener may accept
+var allowedTLSProtocols = []string{"TLSv1.2", "TLSv1.3"}
+
+// NewOCILoadBalancerListenerTLSRule returns a new rule
+func NewOCILoadBalancerListenerTLSRule() *OCILoadBalancerListenerTLSRule {
+	return &OCILoadBalancerListenerTLSRule{}
+}
+
+// Name returns the rule name
+func (r *OCILoadBalancerListenerTLSRule) Name() string {
+	return "oci_load_balancer_listener_tls"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCILoadBalancerListenerTLSRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCILoadBalancerListenerTLSRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCILoadBalancerListenerTLSRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Balance/Tasks/managingciphersuites.htm"
+}
+
+// Check checks if public HTTP listeners have an ssl_configuration, and if ssl_configuration limits protocols and cipher suites
+func (r *OCILoadBalancerListenerTLSRule) Check(runner tflint.Runner) error {
+	config := &ociLoadBalancerListenerTLSRuleConfig{
+		ApprovedCipherSuites: defaultApprovedCipherSuites,
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	public, err := publicLoadBalancers(runner, loadBalancerResourceTypes)
+	if err != nil {
+		return err
+	}
+
+	redirects, err := redirectRuleSets(runner)
+	if err != nil {
+		return err
+	}
+
+	listeners, err := runner.GetResourceContent("oci_load_balancer_listener", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "load_balancer_id"},
+			{Name: "protocol"},
+			{Name: "rule_set_names"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "ssl_configuration",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "protocols"},
+						{Name: "cipher_suite_name"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range listeners.Blocks {
+		addr := resource.Labels[0]
+
+		var sslConfigs hclext.Blocks
+		for _, block := range resource.Body.Blocks {
+			if block.Type == "ssl_configuration" {
+				sslConfigs = append(sslConfigs, block)
+			}
+		}
+
+		if len(sslConfigs) == 0 {
+			loadBalancer := r.publicLoadBalancer(resource, public)
+			if loadBalancer != "" && r.terminatesHTTP(runner, resource) && !r.redirects(resource, redirects) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer Listener '%s' on public load balancer %s does not have an ssl_configuration", addr, loadBalancer),
+					resource.DefRange,
+				)
+			}
+			continue
+		}
+
+		for _, sslConfig := range sslConfigs {
+			r.checkProtocols(runner, resource, sslConfig)
+			r.checkCipherSuite(runner, resource, sslConfig, config)
+		}
+	}
+	return nil
+}
+
+// publicLoadBalancer returns the address of the public load balancer the listener belongs to, empty when it isn't known to be public
+func (r *OCILoadBalancerListenerTLSRule) publicLoadBalancer(resource *hclext.Block, public map[string]bool) string {
+	attr, exists := resource.Body.Attributes["load_balancer_id"]
+	if !exists {
+		return ""
+	}
+
+	for _, loadBalancer := range referencedResources(attr.Expr, loadBalancerResourceTypes) {
+		if public[loadBalancer] {
+			return loadBalancer
+		}
+	}
+	return ""
+}
+
+// terminatesHTTP reports whether the listener handles HTTP traffic. TCP listeners may pass TLS through to the backends.
+func (r *OCILoadBalancerListenerTLSRule) terminatesHTTP(runner tflint.Runner, resource *hclext.Block) bool {
+	attr, exists := resource.Body.Attributes["protocol"]
+	if !exists {
+		return false
+	}
+
+	var protocol string
+	if err := runner.EvaluateExpr(attr.Expr, &protocol, nil); err != nil {
+		return false
+	}
+	return protocol == "HTTP" || protocol == "HTTP2" || protocol == "GRPC"
+}
+
+// redirects reports whether the listener uses a rule set redirecting requests, e.g. from HTTP to HTTPS
+func (r *OCILoadBalancerListenerTLSRule) redirects(resource *hclext.Block, redirects map[string]bool) bool {
+	attr, exists := resource.Body.Attributes["rule_set_names"]
+	if !exists {
+		return false
+	}
+
+	for _, name := range referencedResourceNames(attr.Expr, "oci_load_balancer_rule_set") {
+		if redirects[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// checkProtocols checks if ssl_configuration.protocols only allows TLS 1.2 and TLS 1.3
+func (r *OCILoadBalancerListenerTLSRule) checkProtocols(runner tflint.Runner, resource *hclext.Block, sslConfig *hclext.Block) {
+	addr := resource.Labels[0]
+
+	attr, exists := sslConfig.Body.Attributes["protocols"]
+	if !exists {
+		// The service defaults to TLSv1, TLSv1.1 and TLSv1.2
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Load Balancer Listener '%s' does not restrict ssl_configuration.protocols to TLSv1.2 and TLSv1.3", addr),
+			resource.DefRange,
+		)
+		return
+	}
+
+	var protocols []string
+	if err := runner.EvaluateExpr(attr.Expr, &protocols, nil); err != nil {
+		// Skip if we can't evaluate the protocols
+		return
+	}
+
+	for _, protocol := range protocols {
+		if !slices.Contains(allowedTLSProtocols, protocol) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Load Balancer Listener '%s' allows %s in ssl_configuration.protocols", addr, protocol),
+				attr.Expr.Range(),
+			)
+		}
+	}
+}
+
+// checkCipherSuite checks if ssl_configuration.cipher_suite_name is an approved cipher suite
+func (r *OCILoadBalancerListenerTLSRule) checkCipherSuite(runner tflint.Runner, resource *hclext.Block, sslConfig *hclext.Block, config *ociLoadBalancerListenerTLSRuleConfig) {
+	addr := resource.Labels[0]
+
+	attr, exists := sslConfig.Body.Attributes["cipher_suite_name"]
+	if !exists {
+		// The service defaults to oci-default-ssl-cipher-suite-v1, which includes CBC ciphers
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Load Balancer Listener '%s' does not set ssl_configuration.cipher_suite_name", addr),
+			resource.DefRange,
+		)
+		return
+	}
+
+	var name string
+	if err := runner.EvaluateExpr(attr.Expr, &name, nil); err != nil {
+		// Skip if we can't evaluate the name (likely a reference to a custom cipher suite, checked by oci_load_balancer_ssl_cipher_suite)
+		return
+	}
+
+	if !slices.Contains(config.ApprovedCipherSuites, name) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Load Balancer Listener '%s' uses cipher suite '%s' which is not approved", addr, name),
+			attr.Expr.Range(),
+		)
+	}
+}
+
+// redirectRuleSets returns the names of the rule sets that contain a REDIRECT rule
+func redirectRuleSets(runner tflint.Runner) (map[string]bool, error) {
+	ruleSets, err := runner.GetResourceContent("oci_load_balancer_rule_set", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "items",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "action"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	redirects := map[string]bool{}
+	for _, ruleSet := range ruleSets.Blocks {
+		for _, item := range ruleSet.Body.Blocks {
+			attr, exists := item.Body.Attributes["action"]
+			if !exists {
+				continue
+			}
+
+			var action string
+			if err := runner.EvaluateExpr(attr.Expr, &action, nil); err == nil && action == "REDIRECT" {
+				redirects[ruleSet.Labels[1]] = true
+			}
+		}
+	}
+	return redirects, nil
+}
@@ -0,0 +1,258 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCILoadBalancerListenerTLS(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public HTTP listener without ssl_configuration",
+			Content: `
+resource "oci_load_balancer_load_balancer" "web" {
+  shape = "flexible"
+}
+
+resource "oci_load_balancer_listener" "http" {
+  load_balancer_id = oci_load_balancer_load_balancer.web.id
+  protocol         = "HTTP"
+  port             = 80
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerListenerTLSRule(),
+					Message: "OCI Load Balancer Listener 'oci_load_balancer_listener' on public load balancer oci_load_balancer_load_balancer.web does not have an ssl_configuration",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "public HTTP listener redirecting to HTTPS",
+			Content: `
+resource "oci_load_balancer_load_balancer" "web" {
+  is_private = false
+}
+
+resource "oci_load_balancer_rule_set" "https_redirect" {
+  items {
+    action = "REDIRECT"
+  }
+}
+
+resource "oci_load_balancer_listener" "http" {
+  load_balancer_id = oci_load_balancer_load_balancer.web.id
+  protocol         = "HTTP"
+  port             = 80
+  rule_set_names   = [oci_load_balancer_rule_set.https_redirect.name]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private HTTP listener without ssl_configuration",
+			Content: `
+resource "oci_load_balancer_load_balancer" "internal" {
+  is_private = true
+}
+
+resource "oci_load_balancer_listener" "http" {
+  load_balancer_id = oci_load_balancer_load_balancer.internal.id
+  protocol         = "HTTP"
+  port             = 80
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "public TCP listener passing TLS through",
+			Content: `
+resource "oci_load_balancer_load_balancer" "web" {
+}
+
+resource "oci_load_balancer_listener" "tcp" {
+  load_balancer_id = oci_load_balancer_load_balancer.web.id
+  protocol         = "TCP"
+  port             = 443
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "listener with weak protocols and unapproved cipher suite",
+			Content: `
+resource "oci_load_balancer_listener" "https" {
+  protocol = "HTTP"
+  port     = 443
+
+  ssl_configuration {
+    protocols         = ["TLSv1.1", "TLSv1.2"]
+    cipher_suite_name = "oci-compatible-ssl-cipher-suite-v1"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerListenerTLSRule(),
+					Message: "OCI Load Balancer Listener 'oci_load_balancer_listener' allows TLSv1.1 in ssl_configuration.protocols",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 25},
+						End:      hcl.Pos{Line: 7, Column: 47},
+					},
+				},
+				{
+					Rule:    NewOCILoadBalancerListenerTLSRule(),
+					Message: "OCI Load Balancer Listener 'oci_load_balancer_listener' uses cipher suite 'oci-compatible-ssl-cipher-suite-v1' which is not approved",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 25},
+						End:      hcl.Pos{Line: 8, Column: 61},
+					},
+				},
+			},
+		},
+		{
+			Name: "listener with default protocols and cipher suite",
+			Content: `
+resource "oci_load_balancer_listener" "https" {
+  protocol = "HTTP"
+  port     = 443
+
+  ssl_configuration {
+    certificate_name = "web"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerListenerTLSRule(),
+					Message: "OCI Load Balancer Listener 'oci_load_balancer_listener' does not restrict ssl_configuration.protocols to TLSv1.2 and TLSv1.3",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+				{
+					Rule:    NewOCILoadBalancerListenerTLSRule(),
+					Message: "OCI Load Balancer Listener 'oci_load_balancer_listener' does not set ssl_configuration.cipher_suite_name",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "listener with modern TLS configuration",
+			Content: `
+resource "oci_load_balancer_listener" "https" {
+  protocol = "HTTP"
+  port     = 443
+
+  ssl_configuration {
+    protocols         = ["TLSv1.2", "TLSv1.3"]
+    cipher_suite_name = "oci-default-http2-tls-12-13-ssl-cipher-suite-v1"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "listener with a wider cipher suite",
+			Content: `
+resource "oci_load_balancer_listener" "https" {
+  protocol = "HTTP"
+  port     = 443
+
+  ssl_configuration {
+    protocols         = ["TLSv1.2", "TLSv1.3"]
+    cipher_suite_name = "oci-tls-12-13-wider-ssl-cipher-suite-v1"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerListenerTLSRule(),
+					Message: "OCI Load Balancer Listener 'oci_load_balancer_listener' uses cipher suite 'oci-tls-12-13-wider-ssl-cipher-suite-v1' which is not approved",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 25},
+						End:      hcl.Pos{Line: 8, Column: 66},
+					},
+				},
+			},
+		},
+		{
+			Name: "listener with a wider cipher suite approved in the configuration",
+			Content: `
+resource "oci_load_balancer_listener" "https" {
+  protocol = "HTTP"
+  port     = 443
+
+  ssl_configuration {
+    protocols         = ["TLSv1.2", "TLSv1.3"]
+    cipher_suite_name = "oci-tls-12-13-wider-ssl-cipher-suite-v1"
+  }
+}`,
+			Config: `
+rule "oci_load_balancer_listener_tls" {
+  enabled                = true
+  approved_cipher_suites = ["oci-default-http2-tls-12-13-ssl-cipher-suite-v1", "oci-tls-12-13-wider-ssl-cipher-suite-v1"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "listener with configured approved cipher suite",
+			Content: `
+resource "oci_load_balancer_listener" "https" {
+  protocol = "HTTP"
+  port     = 443
+
+  ssl_configuration {
+    protocols         = ["TLSv1.2"]
+    cipher_suite_name = "oci-modern-ssl-cipher-suite-v1"
+  }
+}`,
+			Config: `
+rule "oci_load_balancer_listener_tls" {
+  enabled                = true
+  approved_cipher_suites = ["oci-tls-13-recommended-ssl-cipher-suite-v1"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerListenerTLSRule(),
+					Message: "OCI Load Balancer Listener 'oci_load_balancer_listener' uses cipher suite 'oci-modern-ssl-cipher-suite-v1' which is not approved",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 25},
+						End:      hcl.Pos{Line: 8, Column: 57},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOCILoadBalancerListenerTLSRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCILoadBalancerPublicRule checks if public OCI Load Balancers are protected by a web application firewall and network security groups
+type OCILoadBalancerPublicRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCILoadBalancerPublicRule returns a new rule
+func NewOCILoadBalancerPublicRule() *OCILoadBalancerPublicRule {
+	return &OCILoadBalancerPublicRule{}
+}
+
+// Name returns the rule name
+func (r *OCILoadBalancerPublicRule) Name() string {
+	return "oci_load_balancer_public"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCILoadBalancerPublicRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCILoadBalancerPublicRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCILoadBalancerPublicRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/WAF/Concepts/overview.htm"
+}
+
+// Check checks if public OCI Load Balancers have a web application firewall referencing them and network security groups attached
+func (r *OCILoadBalancerPublicRule) Check(runner tflint.Runner) error {
+	public, err := publicLoadBalancers(runner, loadBalancerResourceTypes)
+	if err != nil {
+		return err
+	}
+	if len(public) == 0 {
+		return nil
+	}
+
+	firewalls, err := runner.GetResourceContent("oci_waf_web_app_firewall", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "load_balancer_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	protected := map[string]bool{}
+	for _, firewall := range firewalls.Blocks {
+		if attr, exists := firewall.Body.Attributes["load_balancer_id"]; exists {
+			for _, loadBalancer := range referencedResources(attr.Expr, loadBalancerResourceTypes) {
+				protected[loadBalancer] = true
+			}
+		}
+	}
+
+	for _, resourceType := range loadBalancerResourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "network_security_group_ids"},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			addr := resource.Labels[0]
+			if !public[resourceAddress(resource.Labels)] {
+				continue
+			}
+
+			if !protected[resourceAddress(resource.Labels)] {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer '%s' is public but is not protected by a web application firewall", addr),
+					resource.DefRange,
+				)
+			}
+
+			attr, exists := resource.Body.Attributes["network_security_group_ids"]
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer '%s' is public but has no network_security_group_ids", addr),
+					resource.DefRange,
+				)
+				continue
+			}
+
+			var groups []string
+			err := runner.EvaluateExpr(attr.Expr, &groups, nil)
+			if err != nil {
+				// Skip if we can't evaluate the groups (likely references to network security groups)
+				continue
+			}
+
+			if len(groups) == 0 {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer '%s' is public but has no network_security_group_ids", addr),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
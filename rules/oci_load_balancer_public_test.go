@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCILoadBalancerPublic(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public load balancer without WAF or NSGs",
+			Content: `
+resource "oci_load_balancer_load_balancer" "web" {
+  shape = "flexible"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerPublicRule(),
+					Message: "OCI Load Balancer 'oci_load_balancer_load_balancer' is public but is not protected by a web application firewall",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+				{
+					Rule:    NewOCILoadBalancerPublicRule(),
+					Message: "OCI Load Balancer 'oci_load_balancer_load_balancer' is public but has no network_security_group_ids",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "public load balancer with WAF and empty NSGs",
+			Content: `
+resource "oci_load_balancer_load_balancer" "web" {
+  is_private                 = false
+  network_security_group_ids = []
+}
+
+resource "oci_waf_web_app_firewall" "web" {
+  backend_type     = "LOAD_BALANCER"
+  load_balancer_id = oci_load_balancer_load_balancer.web.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerPublicRule(),
+					Message: "OCI Load Balancer 'oci_load_balancer_load_balancer' is public but has no network_security_group_ids",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 32},
+						End:      hcl.Pos{Line: 4, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			Name: "public load balancer with WAF and NSGs",
+			Content: `
+resource "oci_load_balancer_load_balancer" "web" {
+  network_security_group_ids = [oci_core_network_security_group.web.id]
+}
+
+resource "oci_waf_web_app_firewall" "web" {
+  backend_type     = "LOAD_BALANCER"
+  load_balancer_id = oci_load_balancer_load_balancer.web.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "WAF protecting a different load balancer",
+			Content: `
+resource "oci_load_balancer_load_balancer" "web" {
+  network_security_group_ids = [oci_core_network_security_group.web.id]
+}
+
+resource "oci_waf_web_app_firewall" "api" {
+  backend_type     = "LOAD_BALANCER"
+  load_balancer_id = oci_load_balancer_load_balancer.api.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerPublicRule(),
+					Message: "OCI Load Balancer 'oci_load_balancer_load_balancer' is public but is not protected by a web application firewall",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "private load balancer",
+			Content: `
+resource "oci_load_balancer_load_balancer" "internal" {
+  is_private = true
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCILoadBalancerPublicRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCILoadBalancerSSLCipherSuiteRule checks if OCI Load Balancer custom cipher suites include weak ciphers
+type OCILoadBalancerSSLCipherSuiteRule struct {
+	tflint.DefaultRule
+}
+
+// weakCipherMarkers identify ciphers with broken encryption, no encryption, export grade keys, no authentication or an MD5 MAC
+var weakCipherMarkers = []string{"RC4", "DES", "NULL", "EXP", "MD5", "ADH-", "AECDH-"}
+
+// NewOCILoadBalancerSSLCipherSuiteRule returns a new rule
+func NewOCILoadBalancerSSLCipherSuiteRule() *OCILoadBalancerSSLCipherSuiteRule {
+	return &OCILoadBalancerSSLCipherSuiteRule{}
+}
+
+// Name returns the rule name
+func (r *OCILoadBalancerSSLCipherSuiteRule) Name() string {
+	return "oci_load_balancer_ssl_cipher_suite"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCILoadBalancerSSLCipherSuiteRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCILoadBalancerSSLCipherSuiteRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCILoadBalancerSSLCipherSuiteRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Balance/Tasks/managingciphersuites.htm"
+}
+
+// Check checks if OCI Load Balancer SSL cipher suites only include strong ciphers
+func (r *OCILoadBalancerSSLCipherSuiteRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_load_balancer_ssl_cipher_suite", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "ciphers"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		attr, exists := resource.Body.Attributes["ciphers"]
+		if !exists {
+			continue
+		}
+
+		var ciphers []string
+		err := runner.EvaluateExpr(attr.Expr, &ciphers, nil)
+		if err != nil {
+			// Skip if we can't evaluate the ciphers
+			continue
+		}
+
+		for _, cipher := range ciphers {
+			if isWeakCipher(cipher) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Load Balancer SSL Cipher Suite '%s' includes weak cipher '%s'", addr, cipher),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// isWeakCipher reports whether an OpenSSL cipher name is weak. Ciphers ending in "-SHA" use CBC mode with a SHA-1 MAC.
+func isWeakCipher(cipher string) bool {
+	cipher = strings.ToUpper(cipher)
+	for _, marker := range weakCipherMarkers {
+		if strings.Contains(cipher, marker) {
+			return true
+		}
+	}
+	return strings.HasSuffix(cipher, "-SHA")
+}
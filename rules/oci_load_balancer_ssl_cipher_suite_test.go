@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCILoadBalancerSSLCipherSuite(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "cipher suite with weak ciphers",
+			Content: `
+resource "oci_load_balancer_ssl_cipher_suite" "custom" {
+  name    = "custom"
+  ciphers = ["ECDHE-RSA-AES256-GCM-SHA384", "DES-CBC3-SHA", "ECDHE-RSA-AES128-SHA"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerSSLCipherSuiteRule(),
+					Message: "OCI Load Balancer SSL Cipher Suite 'oci_load_balancer_ssl_cipher_suite' includes weak cipher 'DES-CBC3-SHA'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 13},
+						End:      hcl.Pos{Line: 4, Column: 84},
+					},
+				},
+				{
+					Rule:    NewOCILoadBalancerSSLCipherSuiteRule(),
+					Message: "OCI Load Balancer SSL Cipher Suite 'oci_load_balancer_ssl_cipher_suite' includes weak cipher 'ECDHE-RSA-AES128-SHA'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 13},
+						End:      hcl.Pos{Line: 4, Column: 84},
+					},
+				},
+			},
+		},
+		{
+			Name: "cipher suite with RC4",
+			Content: `
+resource "oci_load_balancer_ssl_cipher_suite" "legacy" {
+  name    = "legacy"
+  ciphers = ["RC4-SHA"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCILoadBalancerSSLCipherSuiteRule(),
+					Message: "OCI Load Balancer SSL Cipher Suite 'oci_load_balancer_ssl_cipher_suite' includes weak cipher 'RC4-SHA'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 13},
+						End:      hcl.Pos{Line: 4, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "cipher suite with strong ciphers",
+			Content: `
+resource "oci_load_balancer_ssl_cipher_suite" "custom" {
+  name    = "custom"
+  ciphers = ["ECDHE-ECDSA-AES256-GCM-SHA384", "ECDHE-RSA-CHACHA20-POLY1305", "TLS_AES_128_GCM_SHA256"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCILoadBalancerSSLCipherSuiteRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIMySQLDBSystemResilienceRule checks if production OCI MySQL DB Systems have backups, deletion protection and crash recovery
+type OCIMySQLDBSystemResilienceRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIMySQLDBSystemResilienceRule returns a new rule
+func NewOCIMySQLDBSystemResilienceRule() *OCIMySQLDBSystemResilienceRule {
+	return &OCIMySQLDBSystemResilienceRule{}
+}
+
+// Name returns the rule name
+func (r *OCIMySQLDBSystemResilienceRule) Name() string {
+	return "oci_mysql_db_system_resilience"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIMySQLDBSystemResilienceRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIMySQLDBSystemResilienceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCIMySQLDBSystemResilienceRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/mysql-database/doc/backing-db-system.html"
+}
+
+// Check checks if OCI MySQL DB Systems tagged as production keep automatic backups, are delete protected and have crash recovery enabled
+func (r *OCIMySQLDBSystemResilienceRule) Check(runner tflint.Runner) error {
+	config := newDatabaseResilienceRuleConfig()
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent("oci_mysql_mysql_db_system", &hclext.BodySchema{
+		Attributes: append([]hclext.AttributeSchema{
+			{Name: "crash_recovery"},
+		}, tagAttributeSchemas...),
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "backup_policy",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "is_enabled"},
+						{Name: "retention_in_days"},
+					},
+				},
+			},
+			{
+				Type: "deletion_policy",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "is_delete_protected"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		if !matchesAnyTag(resourceTags(runner, resource.Body), config.ProductionTags) {
+			continue
+		}
+
+		var backupPolicies, deletionPolicies hclext.Blocks
+		for _, block := range resource.Body.Blocks {
+			switch block.Type {
+			case "backup_policy":
+				backupPolicies = append(backupPolicies, block)
+			case "deletion_policy":
+				deletionPolicies = append(deletionPolicies, block)
+			}
+		}
+
+		// Without a backup_policy, backups are kept for the default retention
+		if len(backupPolicies) == 0 {
+			r.checkRetention(runner, resource, defaultMySQLBackupRetentionDays, resource.DefRange, config)
+		}
+		for _, policy := range backupPolicies {
+			r.checkBackupPolicy(runner, resource, policy, config)
+		}
+
+		// is_delete_protected defaults to false
+		if len(deletionPolicies) == 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI MySQL DB System '%s' is tagged as production but is not delete protected", addr),
+				resource.DefRange,
+			)
+		}
+		for _, policy := range deletionPolicies {
+			attr, exists := policy.Body.Attributes["is_delete_protected"]
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI MySQL DB System '%s' is tagged as production but is not delete protected", addr),
+					resource.DefRange,
+				)
+				continue
+			}
+
+			if protected, ok := evaluateBool(runner, attr.Expr); ok && !protected {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI MySQL DB System '%s' is tagged as production but is not delete protected", addr),
+					attr.Expr.Range(),
+				)
+			}
+		}
+
+		// crash_recovery defaults to ENABLED
+		if attr, exists := resource.Body.Attributes["crash_recovery"]; exists {
+			var crashRecovery string
+			err := runner.EvaluateExpr(attr.Expr, &crashRecovery, nil)
+			if err == nil && crashRecovery == "DISABLED" {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI MySQL DB System '%s' is tagged as production but has crash recovery disabled", addr),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// defaultMySQLBackupRetentionDays is how long automatic backups are kept when retention_in_days isn't set
+const defaultMySQLBackupRetentionDays = 7
+
+// checkBackupPolicy checks if automatic backups are enabled and kept long enough. Backups are enabled for 7 days by default.
+func (r *OCIMySQLDBSystemResilienceRule) checkBackupPolicy(runner tflint.Runner, resource *hclext.Block, policy *hclext.Block, config *ociDatabaseResilienceRuleConfig) {
+	addr := resource.Labels[0]
+
+	if attr, exists := policy.Body.Attributes["is_enabled"]; exists {
+		if enabled, ok := evaluateBool(runner, attr.Expr); ok && !enabled {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI MySQL DB System '%s' is tagged as production but does not have automatic backups enabled", addr),
+				attr.Expr.Range(),
+			)
+			return
+		}
+	}
+
+	attr, exists := policy.Body.Attributes["retention_in_days"]
+	if !exists {
+		r.checkRetention(runner, resource, defaultMySQLBackupRetentionDays, policy.DefRange, config)
+		return
+	}
+
+	if days, ok := evaluateNumber(runner, attr.Expr); ok {
+		r.checkRetention(runner, resource, days, attr.Expr.Range(), config)
+	}
+}
+
+// checkRetention reports backup retention shorter than the configured minimum
+func (r *OCIMySQLDBSystemResilienceRule) checkRetention(runner tflint.Runner, resource *hclext.Block, days float64, rng hcl.Range, config *ociDatabaseResilienceRuleConfig) {
+	if days >= float64(config.MinBackupRetentionDays) {
+		return
+	}
+
+	runner.EmitIssue(
+		r,
+		fmt.Sprintf("OCI MySQL DB System '%s' is tagged as production but retains backups for %s days, less than %d", resource.Labels[0], formatNumber(days), config.MinBackupRetentionDays),
+		rng,
+	)
+}
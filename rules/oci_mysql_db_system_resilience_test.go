@@ -0,0 +1,222 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIMySQLDBSystemResilience(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "production DB system without deletion policy",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIMySQLDBSystemResilienceRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is tagged as production but is not delete protected",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "production DB system with backups disabled and crash recovery disabled",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  crash_recovery = "DISABLED"
+
+  backup_policy {
+    is_enabled = false
+  }
+
+  deletion_policy {
+    is_delete_protected = false
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIMySQLDBSystemResilienceRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is tagged as production but does not have automatic backups enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 18},
+						End:      hcl.Pos{Line: 6, Column: 23},
+					},
+				},
+				{
+					Rule:    NewOCIMySQLDBSystemResilienceRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is tagged as production but is not delete protected",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 10, Column: 27},
+						End:      hcl.Pos{Line: 10, Column: 32},
+					},
+				},
+				{
+					Rule:    NewOCIMySQLDBSystemResilienceRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is tagged as production but has crash recovery disabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "production DB system with short backup retention",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  backup_policy {
+    is_enabled        = true
+    retention_in_days = 1
+  }
+
+  deletion_policy {
+    is_delete_protected = true
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIMySQLDBSystemResilienceRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is tagged as production but retains backups for 1 days, less than 7",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 25},
+						End:      hcl.Pos{Line: 5, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "production DB system without backup policy under a longer minimum retention",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  deletion_policy {
+    is_delete_protected = true
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Config: `
+rule "oci_mysql_db_system_resilience" {
+  enabled                   = true
+  min_backup_retention_days = 30
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIMySQLDBSystemResilienceRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is tagged as production but retains backups for 7 days, less than 30",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "production DB system with backup policy without retention under a longer minimum retention",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  backup_policy {
+    is_enabled = true
+  }
+
+  deletion_policy {
+    is_delete_protected = true
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Config: `
+rule "oci_mysql_db_system_resilience" {
+  enabled                   = true
+  min_backup_retention_days = 30
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIMySQLDBSystemResilienceRule(),
+					Message: "OCI MySQL DB System 'oci_mysql_mysql_db_system' is tagged as production but retains backups for 7 days, less than 30",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "production DB system with resilient configuration",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  crash_recovery = "ENABLED"
+
+  backup_policy {
+    retention_in_days = 35
+  }
+
+  deletion_policy {
+    is_delete_protected = true
+  }
+
+  freeform_tags = {
+    environment = "production"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "untagged DB system",
+			Content: `
+resource "oci_mysql_mysql_db_system" "mysql" {
+  crash_recovery = "DISABLED"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIMySQLDBSystemResilienceRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
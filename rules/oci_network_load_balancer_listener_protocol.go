@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCINetworkLoadBalancerListenerProtocolRule checks if public OCI Network Load Balancer listeners forward any protocol or port
+type OCINetworkLoadBalancerListenerProtocolRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCINetworkLoadBalancerListenerProtocolRule returns a new rule
+func NewOCINetworkLoadBalancerListenerProtocolRule() *OCINetworkLoadBalancerListenerProtocolRule {
+	return &OCINetworkLoadBalancerListenerProtocolRule{}
+}
+
+// Name returns the rule name
+func (r *OCINetworkLoadBalancerListenerProtocolRule) Name() string {
+	return "oci_network_load_balancer_listener_protocol"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCINetworkLoadBalancerListenerProtocolRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCINetworkLoadBalancerListenerProtocolRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCINetworkLoadBalancerListenerProtocolRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/NetworkLoadBalancer/Listeners/listener-management.htm"
+}
+
+// Check checks if listeners of public OCI Network Load Balancers use a specific protocol and port
+func (r *OCINetworkLoadBalancerListenerProtocolRule) Check(runner tflint.Runner) error {
+	public, err := publicLoadBalancers(runner, networkLoadBalancerResourceTypes)
+	if err != nil {
+		return err
+	}
+
+	listeners, err := runner.GetResourceContent("oci_network_load_balancer_listener", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "network_load_balancer_id"},
+			{Name: "protocol"},
+			{Name: "port"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range listeners.Blocks {
+		addr := resource.Labels[0]
+
+		lbAttr, exists := resource.Body.Attributes["network_load_balancer_id"]
+		if !exists {
+			continue
+		}
+
+		var loadBalancer string
+		for _, candidate := range referencedResources(lbAttr.Expr, networkLoadBalancerResourceTypes) {
+			if public[candidate] {
+				loadBalancer = candidate
+			}
+		}
+		if loadBalancer == "" {
+			continue
+		}
+
+		if attr, exists := resource.Body.Attributes["protocol"]; exists {
+			var protocol string
+			if err := runner.EvaluateExpr(attr.Expr, &protocol, nil); err == nil && protocol == "ANY" {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Network Load Balancer Listener '%s' on public network load balancer %s forwards any protocol", addr, loadBalancer),
+					attr.Expr.Range(),
+				)
+			}
+		}
+
+		if attr, exists := resource.Body.Attributes["port"]; exists {
+			var port int
+			if err := runner.EvaluateExpr(attr.Expr, &port, nil); err == nil && port == 0 {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Network Load Balancer Listener '%s' on public network load balancer %s forwards all ports", addr, loadBalancer),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCINetworkLoadBalancerListenerProtocol(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public listener forwarding any protocol on all ports",
+			Content: `
+resource "oci_network_load_balancer_network_load_balancer" "edge" {
+  is_private = false
+}
+
+resource "oci_network_load_balancer_listener" "any" {
+  network_load_balancer_id = oci_network_load_balancer_network_load_balancer.edge.id
+  protocol                 = "ANY"
+  port                     = 0
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkLoadBalancerListenerProtocolRule(),
+					Message: "OCI Network Load Balancer Listener 'oci_network_load_balancer_listener' on public network load balancer oci_network_load_balancer_network_load_balancer.edge forwards any protocol",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 30},
+						End:      hcl.Pos{Line: 8, Column: 35},
+					},
+				},
+				{
+					Rule:    NewOCINetworkLoadBalancerListenerProtocolRule(),
+					Message: "OCI Network Load Balancer Listener 'oci_network_load_balancer_listener' on public network load balancer oci_network_load_balancer_network_load_balancer.edge forwards all ports",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 30},
+						End:      hcl.Pos{Line: 9, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "public TCP listener on a single port",
+			Content: `
+resource "oci_network_load_balancer_network_load_balancer" "edge" {
+}
+
+resource "oci_network_load_balancer_listener" "tls" {
+  network_load_balancer_id = oci_network_load_balancer_network_load_balancer.edge.id
+  protocol                 = "TCP"
+  port                     = 443
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private listener forwarding any protocol",
+			Content: `
+resource "oci_network_load_balancer_network_load_balancer" "internal" {
+  is_private = true
+}
+
+resource "oci_network_load_balancer_listener" "any" {
+  network_load_balancer_id = oci_network_load_balancer_network_load_balancer.internal.id
+  protocol                 = "ANY"
+  port                     = 0
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCINetworkLoadBalancerListenerProtocolRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package rules
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/joelp172/tflint-ruleset-oci/rules/cidr"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCINetworkUnrestrictedEgressRule checks if restricted OCI network security groups and security lists allow unrestricted egress
+type OCINetworkUnrestrictedEgressRule struct {
+	tflint.DefaultRule
+}
+
+// ociNetworkUnrestrictedEgressRuleConfig is the rule configuration
+type ociNetworkUnrestrictedEgressRuleConfig struct {
+	// RestrictedTags mark network security groups, security lists and subnets as restricted
+	RestrictedTags map[string]string `hclext:"restricted_tags,optional"`
+	// AllowedDestinations are the destinations restricted resources may send all traffic to. When set, public CIDR
+	// blocks must be contained in one of its CIDR blocks, and service CIDR labels must match one of its entries,
+	// which may use path.Match patterns such as "all-*-services-in-oracle-services-network"
+	AllowedDestinations []string `hclext:"allowed_destinations,optional"`
+}
+
+// NewOCINetworkUnrestrictedEgressRule returns a new rule
+func NewOCINetworkUnrestrictedEgressRule() *OCINetworkUnrestrictedEgressRule {
+	return &OCINetworkUnrestrictedEgressRule{}
+}
+
+// Name returns the rule name
+func (r *OCINetworkUnrestrictedEgressRule) Name() string {
+	return "oci_network_unrestricted_egress"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCINetworkUnrestrictedEgressRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *OCINetworkUnrestrictedEgressRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *OCINetworkUnrestrictedEgressRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Network/Concepts/securityrules.htm"
+}
+
+var egressRuleAttributeSchemas = []hclext.AttributeSchema{
+	{Name: "destination"},
+	{Name: "destination_type"},
+	{Name: "protocol"},
+}
+
+// Check checks if egress rules of restricted network security groups and security lists allow all protocols to unrestricted destinations
+func (r *OCINetworkUnrestrictedEgressRule) Check(runner tflint.Runner) error {
+	config := &ociNetworkUnrestrictedEgressRuleConfig{
+		RestrictedTags: map[string]string{"tier": "restricted"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	if err := r.checkNetworkSecurityGroups(runner, config); err != nil {
+		return err
+	}
+	return r.checkSecurityLists(runner, config)
+}
+
+// checkNetworkSecurityGroups checks the egress rules of network security groups tagged as restricted
+func (r *OCINetworkUnrestrictedEgressRule) checkNetworkSecurityGroups(runner tflint.Runner, config *ociNetworkUnrestrictedEgressRuleConfig) error {
+	groups, err := runner.GetResourceContent("oci_core_network_security_group", &hclext.BodySchema{
+		Attributes: tagAttributeSchemas,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	restricted := map[string]bool{}
+	for _, group := range groups.Blocks {
+		if matchesAnyTag(resourceTags(runner, group.Body), config.RestrictedTags) {
+			restricted[group.Labels[1]] = true
+		}
+	}
+	if len(restricted) == 0 {
+		return nil
+	}
+
+	rules, err := runner.GetResourceContent("oci_core_network_security_group_security_rule", &hclext.BodySchema{
+		Attributes: append([]hclext.AttributeSchema{
+			{Name: "direction"},
+			{Name: "network_security_group_id"},
+		}, egressRuleAttributeSchemas...),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range rules.Blocks {
+		addr := resource.Labels[0]
+
+		groupAttr, exists := resource.Body.Attributes["network_security_group_id"]
+		if !exists {
+			continue
+		}
+
+		var group string
+		for _, name := range referencedResourceNames(groupAttr.Expr, "oci_core_network_security_group") {
+			if restricted[name] {
+				group = name
+			}
+		}
+		if group == "" {
+			continue
+		}
+
+		dirAttr, exists := resource.Body.Attributes["direction"]
+		if !exists {
+			continue
+		}
+
+		var direction string
+		if err := runner.EvaluateExpr(dirAttr.Expr, &direction, nil); err != nil || direction != "EGRESS" {
+			continue
+		}
+
+		r.checkEgressRule(runner, resource.Body, config, fmt.Sprintf("OCI Network Security Group Rule '%s'", addr), fmt.Sprintf("network security group '%s'", group))
+	}
+	return nil
+}
+
+// checkSecurityLists checks the egress rules of security lists tagged as restricted or used by subnets tagged as restricted
+func (r *OCINetworkUnrestrictedEgressRule) checkSecurityLists(runner tflint.Runner, config *ociNetworkUnrestrictedEgressRuleConfig) error {
+	subnets, err := runner.GetResourceContent("oci_core_subnet", &hclext.BodySchema{
+		Attributes: append([]hclext.AttributeSchema{{Name: "security_list_ids"}}, tagAttributeSchemas...),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// Collect the security lists used by restricted subnets, keyed by resource type and name
+	restricted := map[string]map[string]bool{
+		"oci_core_security_list":         {},
+		"oci_core_default_security_list": {},
+	}
+	for _, subnet := range subnets.Blocks {
+		attr, exists := subnet.Body.Attributes["security_list_ids"]
+		if !exists || !matchesAnyTag(resourceTags(runner, subnet.Body), config.RestrictedTags) {
+			continue
+		}
+
+		for resourceType, names := range restricted {
+			for _, name := range referencedResourceNames(attr.Expr, resourceType) {
+				names[name] = true
+			}
+		}
+	}
+
+	for _, resourceType := range []string{"oci_core_security_list", "oci_core_default_security_list"} {
+		lists, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: tagAttributeSchemas,
+			Blocks: []hclext.BlockSchema{
+				{
+					Type: "egress_security_rules",
+					Body: &hclext.BodySchema{Attributes: egressRuleAttributeSchemas},
+				},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range lists.Blocks {
+			addr := resource.Labels[0]
+
+			if !restricted[resourceType][resource.Labels[1]] && !matchesAnyTag(resourceTags(runner, resource.Body), config.RestrictedTags) {
+				continue
+			}
+
+			for _, rule := range resource.Body.Blocks {
+				r.checkEgressRule(runner, rule.Body, config, fmt.Sprintf("OCI Security List '%s'", addr), fmt.Sprintf("security list '%s'", resource.Labels[1]))
+			}
+		}
+	}
+	return nil
+}
+
+// checkEgressRule checks if an egress rule allows all protocols to an unrestricted or, when an allowlist is configured,
+// public destination that is not allowed
+func (r *OCINetworkUnrestrictedEgressRule) checkEgressRule(runner tflint.Runner, body *hclext.BodyContent, config *ociNetworkUnrestrictedEgressRuleConfig, subject, restricted string) {
+	protocolAttr, exists := body.Attributes["protocol"]
+	if !exists {
+		return
+	}
+
+	var protocol string
+	if err := runner.EvaluateExpr(protocolAttr.Expr, &protocol, nil); err != nil || protocol != "all" {
+		return
+	}
+
+	destAttr, exists := body.Attributes["destination"]
+	if !exists {
+		return
+	}
+
+	var destination string
+	if err := runner.EvaluateExpr(destAttr.Expr, &destination, nil); err != nil {
+		// Skip if we can't evaluate the destination (likely a reference to another resource)
+		return
+	}
+
+	destinationType := "CIDR_BLOCK"
+	if typeAttr, exists := body.Attributes["destination_type"]; exists {
+		if err := runner.EvaluateExpr(typeAttr.Expr, &destinationType, nil); err != nil {
+			return
+		}
+	}
+
+	switch destinationType {
+	case "SERVICE_CIDR_BLOCK":
+		// Service CIDR labels are only checked against an allowlist
+		if len(config.AllowedDestinations) == 0 {
+			return
+		}
+		for _, allowed := range config.AllowedDestinations {
+			if matched, _ := path.Match(allowed, destination); matched {
+				return
+			}
+		}
+	case "CIDR_BLOCK":
+		prefix, err := cidr.Parse(destination)
+		if err != nil {
+			return
+		}
+
+		// Internal CIDR blocks are never checked. Unrestricted blocks always are, other public blocks only
+		// against an allowlist.
+		if cidr.IsPrivate(prefix) || (len(config.AllowedDestinations) == 0 && !isUnrestrictedCIDR(destination)) {
+			return
+		}
+		for _, allowed := range config.AllowedDestinations {
+			if allowedPrefix, err := cidr.Parse(allowed); err == nil && cidr.Contains(allowedPrefix, prefix) {
+				return
+			}
+		}
+	default:
+		// Other destination types, such as network security groups, don't leave the VCN
+		return
+	}
+
+	runner.EmitIssue(
+		r,
+		fmt.Sprintf("%s allows egress for all protocols to %s from restricted %s", subject, destination, restricted),
+		destAttr.Expr.Range(),
+	)
+}
@@ -0,0 +1,293 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCINetworkUnrestrictedEgress(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "restricted NSG with egress to anywhere",
+			Content: `
+resource "oci_core_network_security_group" "db" {
+  freeform_tags = {
+    tier = "restricted"
+  }
+}
+
+resource "oci_core_network_security_group_security_rule" "db_egress" {
+  network_security_group_id = oci_core_network_security_group.db.id
+  direction                 = "EGRESS"
+  protocol                  = "all"
+  destination               = "0.0.0.0/0"
+  destination_type          = "CIDR_BLOCK"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkUnrestrictedEgressRule(),
+					Message: "OCI Network Security Group Rule 'oci_core_network_security_group_security_rule' allows egress for all protocols to 0.0.0.0/0 from restricted network security group 'db'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 12, Column: 31},
+						End:      hcl.Pos{Line: 12, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "restricted NSG with HTTPS egress to anywhere",
+			Content: `
+resource "oci_core_network_security_group" "db" {
+  freeform_tags = {
+    tier = "restricted"
+  }
+}
+
+resource "oci_core_network_security_group_security_rule" "db_egress" {
+  network_security_group_id = oci_core_network_security_group.db.id
+  direction                 = "EGRESS"
+  protocol                  = "6"
+  destination               = "0.0.0.0/0"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unrestricted NSG with egress to anywhere",
+			Content: `
+resource "oci_core_network_security_group" "web" {
+}
+
+resource "oci_core_network_security_group_security_rule" "web_egress" {
+  network_security_group_id = oci_core_network_security_group.web.id
+  direction                 = "EGRESS"
+  protocol                  = "all"
+  destination               = "0.0.0.0/0"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "security list used by restricted subnet",
+			Content: `
+resource "oci_core_security_list" "db" {
+  egress_security_rules {
+    protocol    = "all"
+    destination = "::/0"
+  }
+}
+
+resource "oci_core_subnet" "db" {
+  security_list_ids = [oci_core_security_list.db.id]
+  freeform_tags = {
+    tier = "restricted"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkUnrestrictedEgressRule(),
+					Message: "OCI Security List 'oci_core_security_list' allows egress for all protocols to ::/0 from restricted security list 'db'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 19},
+						End:      hcl.Pos{Line: 5, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "restricted security list with egress to the Oracle Services Network",
+			Content: `
+resource "oci_core_security_list" "db" {
+  freeform_tags = {
+    tier = "restricted"
+  }
+
+  egress_security_rules {
+    protocol         = "all"
+    destination      = "all-iad-services-in-oracle-services-network"
+    destination_type = "SERVICE_CIDR_BLOCK"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "allowlist configured with egress to a private CIDR block",
+			Content: `
+resource "oci_core_security_list" "db" {
+  defined_tags = {
+    "Security.zone" = "restricted"
+  }
+
+  egress_security_rules {
+    protocol         = "all"
+    destination      = "all-iad-services-in-oracle-services-network"
+    destination_type = "SERVICE_CIDR_BLOCK"
+  }
+
+  egress_security_rules {
+    protocol    = "all"
+    destination = "10.1.0.0/16"
+  }
+}`,
+			Config: `
+rule "oci_network_unrestricted_egress" {
+  enabled         = true
+  restricted_tags = {
+    "Security.zone" = "restricted"
+  }
+  allowed_destinations = ["all-*-services-in-oracle-services-network", "203.0.113.0/24"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "allowlist with service CIDR labels and public CIDR blocks",
+			Content: `
+resource "oci_core_security_list" "db" {
+  freeform_tags = {
+    tier = "restricted"
+  }
+
+  egress_security_rules {
+    protocol         = "all"
+    destination      = "all-iad-services-in-oracle-services-network"
+    destination_type = "SERVICE_CIDR_BLOCK"
+  }
+
+  egress_security_rules {
+    protocol         = "all"
+    destination      = "oci-iad-objectstorage"
+    destination_type = "SERVICE_CIDR_BLOCK"
+  }
+
+  egress_security_rules {
+    protocol    = "all"
+    destination = "203.0.113.10/32"
+  }
+
+  egress_security_rules {
+    protocol    = "all"
+    destination = "198.51.100.0/24"
+  }
+}`,
+			Config: `
+rule "oci_network_unrestricted_egress" {
+  enabled              = true
+  allowed_destinations = ["all-*-services-in-oracle-services-network", "203.0.113.0/24"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkUnrestrictedEgressRule(),
+					Message: "OCI Security List 'oci_core_security_list' allows egress for all protocols to oci-iad-objectstorage from restricted security list 'db'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 15, Column: 24},
+						End:      hcl.Pos{Line: 15, Column: 47},
+					},
+				},
+				{
+					Rule:    NewOCINetworkUnrestrictedEgressRule(),
+					Message: "OCI Security List 'oci_core_security_list' allows egress for all protocols to 198.51.100.0/24 from restricted security list 'db'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 26, Column: 19},
+						End:      hcl.Pos{Line: 26, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "public CIDR block without an allowlist",
+			Content: `
+resource "oci_core_security_list" "db" {
+  freeform_tags = {
+    tier = "restricted"
+  }
+
+  egress_security_rules {
+    protocol    = "all"
+    destination = "198.51.100.0/24"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "allowlisted internet egress",
+			Content: `
+resource "oci_core_security_list" "db" {
+  freeform_tags = {
+    tier = "restricted"
+  }
+
+  egress_security_rules {
+    protocol    = "all"
+    destination = "0.0.0.0/0"
+  }
+}`,
+			Config: `
+rule "oci_network_unrestricted_egress" {
+  enabled              = true
+  allowed_destinations = ["0.0.0.0/0"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "allowlist not containing the unrestricted destination",
+			Content: `
+resource "oci_core_security_list" "db" {
+  freeform_tags = {
+    tier = "restricted"
+  }
+
+  egress_security_rules {
+    protocol    = "all"
+    destination = "0.0.0.0/0"
+  }
+
+  egress_security_rules {
+    protocol    = "all"
+    destination = "::/0"
+  }
+}`,
+			Config: `
+rule "oci_network_unrestricted_egress" {
+  enabled              = true
+  allowed_destinations = ["::/0"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkUnrestrictedEgressRule(),
+					Message: "OCI Security List 'oci_core_security_list' allows egress for all protocols to 0.0.0.0/0 from restricted security list 'db'",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 19},
+						End:      hcl.Pos{Line: 9, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOCINetworkUnrestrictedEgressRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
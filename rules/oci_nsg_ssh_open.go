@@ -2,6 +2,8 @@ package rules
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -37,13 +39,27 @@ func (r *OCINetworkSecurityGroupSSHRule) Link() string {
 	return "https://docs.oracle.com/en-us/iaas/Content/Security/Reference/networksecurity_topic.htm"
 }
 
-// Check checks if OCI network security group allows unrestricted ingress access to port 22
+// sshIngressRule is a network security group rule allowing ingress access to port 22
+type sshIngressRule struct {
+	resource *hclext.Block
+	// group is the name of the network security group the rule belongs to, empty when it isn't declared in the module
+	group string
+	// source is the unrestricted CIDR block the rule allows, empty for network security group sources
+	source string
+	// sourceGroup is the name of the network security group the rule allows, empty for CIDR block sources
+	sourceGroup string
+}
+
+// Check checks if OCI network security group allows unrestricted ingress access to port 22, either directly
+// or from another network security group that is itself exposed
 func (r *OCINetworkSecurityGroupSSHRule) Check(runner tflint.Runner) error {
 	resources, err := runner.GetResourceContent("oci_core_network_security_group_security_rule", &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: "direction"},
 			{Name: "source"},
+			{Name: "source_type"},
 			{Name: "protocol"},
+			{Name: "network_security_group_id"},
 		},
 		Blocks: []hclext.BlockSchema{
 			{
@@ -68,102 +84,193 @@ func (r *OCINetworkSecurityGroupSSHRule) Check(runner tflint.Runner) error {
 		return err
 	}
 
+	var sshRules []sshIngressRule
 	for _, resource := range resources.Blocks {
-		addr := resource.Labels[0]
+		rule, matched, err := r.sshIngress(runner, resource)
+		if err != nil {
+			return err
+		}
+		if matched {
+			sshRules = append(sshRules, rule)
+		}
+	}
 
-		// Check direction
-		dirAttr, exists := resource.Body.Attributes["direction"]
-		if !exists {
+	// exposed maps each network security group reachable over SSH from an unrestricted source
+	// to the chain of sources leading to it, e.g. ["0.0.0.0/0", "oci_core_network_security_group.bastion"]
+	exposed := map[string][]string{}
+	for _, rule := range sshRules {
+		addr := rule.resource.Labels[0]
+		if rule.source == "" {
 			continue
 		}
 
-		var direction string
-		err := runner.EvaluateExpr(dirAttr.Expr, &direction, nil)
-		if err != nil {
-			return err
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Security Group rule '%s' allows unrestricted ingress access to port 22", addr),
+			rule.resource.DefRange,
+		)
+
+		if rule.group != "" {
+			if _, exists := exposed[rule.group]; !exists {
+				exposed[rule.group] = []string{rule.source, "oci_core_network_security_group." + rule.group}
+			}
 		}
+	}
 
-		if direction != "INGRESS" {
-			continue
+	// Propagate exposure through rules sourced from exposed network security groups until nothing changes
+	for changed := true; changed; {
+		changed = false
+		for _, rule := range sshRules {
+			chain, sourceExposed := exposed[rule.sourceGroup]
+			if rule.sourceGroup == "" || !sourceExposed || rule.group == "" {
+				continue
+			}
+			if _, exists := exposed[rule.group]; exists {
+				continue
+			}
+
+			exposed[rule.group] = append(slices.Clone(chain), "oci_core_network_security_group."+rule.group)
+			changed = true
 		}
+	}
+
+	for _, rule := range sshRules {
+		addr := rule.resource.Labels[0]
 
-		// Check source
-		sourceAttr, exists := resource.Body.Attributes["source"]
-		if !exists {
+		chain, sourceExposed := exposed[rule.sourceGroup]
+		if rule.sourceGroup == "" || !sourceExposed {
 			continue
 		}
 
+		if rule.group != "" {
+			chain = append(slices.Clone(chain), "oci_core_network_security_group."+rule.group)
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Security Group rule '%s' allows ingress access to port 22 from network security group oci_core_network_security_group.%s which is exposed to unrestricted SSH access (%s)", addr, rule.sourceGroup, strings.Join(chain, " -> ")),
+			rule.resource.DefRange,
+		)
+	}
+	return nil
+}
+
+// sshIngress returns the rule when it allows ingress access to port 22 from an unrestricted CIDR block or from a network security group
+func (r *OCINetworkSecurityGroupSSHRule) sshIngress(runner tflint.Runner, resource *hclext.Block) (sshIngressRule, bool, error) {
+	rule := sshIngressRule{resource: resource}
+
+	if attr, exists := resource.Body.Attributes["network_security_group_id"]; exists {
+		if names := referencedResourceNames(attr.Expr, "oci_core_network_security_group"); len(names) > 0 {
+			rule.group = names[0]
+		}
+	}
+
+	// Check direction
+	dirAttr, exists := resource.Body.Attributes["direction"]
+	if !exists {
+		return rule, false, nil
+	}
+
+	var direction string
+	err := runner.EvaluateExpr(dirAttr.Expr, &direction, nil)
+	if err != nil {
+		return rule, false, err
+	}
+
+	if direction != "INGRESS" {
+		return rule, false, nil
+	}
+
+	// Check source
+	sourceAttr, exists := resource.Body.Attributes["source"]
+	if !exists {
+		return rule, false, nil
+	}
+
+	// source_type defaults to CIDR_BLOCK
+	sourceType := "CIDR_BLOCK"
+	if attr, exists := resource.Body.Attributes["source_type"]; exists {
+		if err := runner.EvaluateExpr(attr.Expr, &sourceType, nil); err != nil {
+			// Skip if we can't evaluate the source type, we can't tell how to read the source
+			return rule, false, nil
+		}
+	}
+
+	switch sourceType {
+	case "NETWORK_SECURITY_GROUP":
+		names := referencedResourceNames(sourceAttr.Expr, "oci_core_network_security_group")
+		if len(names) == 0 {
+			// Skip network security groups that aren't declared in the module
+			return rule, false, nil
+		}
+		rule.sourceGroup = names[0]
+	case "CIDR_BLOCK":
 		var source string
 		err = runner.EvaluateExpr(sourceAttr.Expr, &source, nil)
 		if err != nil {
 			// Skip if we can't evaluate the source (likely a reference to another resource)
-			continue
+			return rule, false, nil
 		}
 
-		if source != "0.0.0.0/0" {
-			continue
+		if !isUnrestrictedCIDR(source) {
+			return rule, false, nil
 		}
+		rule.source = source
+	default:
+		// SERVICE_CIDR_BLOCK sources are Oracle Services Network labels, not internet addresses
+		return rule, false, nil
+	}
 
-		// Check protocol
-		protocolAttr, exists := resource.Body.Attributes["protocol"]
-		if !exists {
-			continue
-		}
+	// Check protocol
+	protocolAttr, exists := resource.Body.Attributes["protocol"]
+	if !exists {
+		return rule, false, nil
+	}
 
-		var protocol string
-		err = runner.EvaluateExpr(protocolAttr.Expr, &protocol, nil)
-		if err != nil {
-			return err
-		}
+	var protocol string
+	err = runner.EvaluateExpr(protocolAttr.Expr, &protocol, nil)
+	if err != nil {
+		return rule, false, err
+	}
+
+	if protocol != "6" && protocol != "all" { // 6 is TCP
+		return rule, false, nil
+	}
 
-		if protocol != "6" && protocol != "all" { // 6 is TCP
+	// Check port ranges
+	for _, tcpOption := range resource.Body.Blocks {
+		if tcpOption.Type != "tcp_options" {
 			continue
 		}
 
-		// Check port ranges
-		var tcpOptions hclext.Blocks
-		for _, block := range resource.Body.Blocks {
-			if block.Type == "tcp_options" {
-				tcpOptions = append(tcpOptions, block)
+		for _, portRange := range tcpOption.Body.Blocks {
+			if portRange.Type != "destination_port_range" {
+				continue
 			}
-		}
-		for _, tcpOption := range tcpOptions {
-			var portRanges hclext.Blocks
-			for _, block := range tcpOption.Body.Blocks {
-				if block.Type == "destination_port_range" {
-					portRanges = append(portRanges, block)
-				}
+
+			minAttr, minExists := portRange.Body.Attributes["min"]
+			maxAttr, maxExists := portRange.Body.Attributes["max"]
+
+			if !minExists || !maxExists {
+				continue
+			}
+
+			var min, max int
+			err = runner.EvaluateExpr(minAttr.Expr, &min, nil)
+			if err != nil {
+				return rule, false, err
 			}
 
-			for _, portRange := range portRanges {
-				minAttr, minExists := portRange.Body.Attributes["min"]
-				maxAttr, maxExists := portRange.Body.Attributes["max"]
-
-				if !minExists || !maxExists {
-					continue
-				}
-
-				var min, max int
-				err = runner.EvaluateExpr(minAttr.Expr, &min, nil)
-				if err != nil {
-					return err
-				}
-
-				err = runner.EvaluateExpr(maxAttr.Expr, &max, nil)
-				if err != nil {
-					return err
-				}
-
-				// Check if port 22 is in range
-				if min <= 22 && max >= 22 {
-					runner.EmitIssue(
-						r,
-						fmt.Sprintf("OCI Security Group rule '%s' allows unrestricted ingress access to port 22", addr),
-						resource.DefRange,
-					)
-				}
+			err = runner.EvaluateExpr(maxAttr.Expr, &max, nil)
+			if err != nil {
+				return rule, false, err
+			}
+
+			// Check if port 22 is in range
+			if min <= 22 && max >= 22 {
+				return rule, true, nil
 			}
 		}
 	}
-	return nil
+	return rule, false, nil
 }
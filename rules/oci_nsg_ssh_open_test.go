@@ -194,6 +194,228 @@ resource "oci_core_network_security_group_security_rule" "test" {
 				},
 			},
 		},
+		{
+			Name: "IPv6 ::/0 source",
+			Content: `
+resource "oci_core_network_security_group_security_rule" "test" {
+	direction = "INGRESS"
+	source = "::/0"
+	protocol = "6"
+	
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkSecurityGroupSSHRule(),
+					Message: "OCI Security Group rule 'oci_core_network_security_group_security_rule' allows unrestricted ingress access to port 22",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 64},
+					},
+				},
+			},
+		},
+		{
+			Name: "Leading zeros in prefix length",
+			Content: `
+resource "oci_core_network_security_group_security_rule" "test" {
+	direction = "INGRESS"
+	source = "0.0.0.0/00"
+	protocol = "6"
+	
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkSecurityGroupSSHRule(),
+					Message: "OCI Security Group rule 'oci_core_network_security_group_security_rule' allows unrestricted ingress access to port 22",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 64},
+					},
+				},
+			},
+		},
+		{
+			Name: "Host bits set on /0",
+			Content: `
+resource "oci_core_network_security_group_security_rule" "test" {
+	direction = "INGRESS"
+	source = "10.0.0.0/0"
+	protocol = "6"
+	
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkSecurityGroupSSHRule(),
+					Message: "OCI Security Group rule 'oci_core_network_security_group_security_rule' allows unrestricted ingress access to port 22",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 64},
+					},
+				},
+			},
+		},
+		{
+			Name: "Half of the internet",
+			Content: `
+resource "oci_core_network_security_group_security_rule" "test" {
+	direction = "INGRESS"
+	source = "0.0.0.0/1"
+	protocol = "6"
+	
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkSecurityGroupSSHRule(),
+					Message: "OCI Security Group rule 'oci_core_network_security_group_security_rule' allows unrestricted ingress access to port 22",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 64},
+					},
+				},
+			},
+		},
+		{
+			Name: "Service CIDR label source",
+			Content: `
+resource "oci_core_network_security_group_security_rule" "test" {
+	direction = "INGRESS"
+	source = "all-iad-services-in-oracle-services-network"
+	source_type = "SERVICE_CIDR_BLOCK"
+	protocol = "6"
+
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "NSG source that is not exposed",
+			Content: `
+resource "oci_core_network_security_group_security_rule" "app_ssh" {
+	network_security_group_id = oci_core_network_security_group.app.id
+	direction = "INGRESS"
+	source = oci_core_network_security_group.bastion.id
+	source_type = "NETWORK_SECURITY_GROUP"
+	protocol = "6"
+
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "NSG source chain from an exposed NSG",
+			Content: `
+resource "oci_core_network_security_group_security_rule" "db_ssh" {
+	network_security_group_id = oci_core_network_security_group.db.id
+	direction = "INGRESS"
+	source = oci_core_network_security_group.app.id
+	source_type = "NETWORK_SECURITY_GROUP"
+	protocol = "6"
+
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}
+
+resource "oci_core_network_security_group_security_rule" "app_ssh" {
+	network_security_group_id = oci_core_network_security_group.app.id
+	direction = "INGRESS"
+	source = oci_core_network_security_group.bastion.id
+	source_type = "NETWORK_SECURITY_GROUP"
+	protocol = "6"
+
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}
+
+resource "oci_core_network_security_group_security_rule" "bastion_ssh" {
+	network_security_group_id = oci_core_network_security_group.bastion.id
+	direction = "INGRESS"
+	source = "0.0.0.0/0"
+	source_type = "CIDR_BLOCK"
+	protocol = "6"
+
+	tcp_options {
+		destination_port_range {
+			min = 22
+			max = 22
+		}
+	}
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCINetworkSecurityGroupSSHRule(),
+					Message: "OCI Security Group rule 'oci_core_network_security_group_security_rule' allows unrestricted ingress access to port 22",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 32, Column: 1},
+						End:      hcl.Pos{Line: 32, Column: 71},
+					},
+				},
+				{
+					Rule:    NewOCINetworkSecurityGroupSSHRule(),
+					Message: "OCI Security Group rule 'oci_core_network_security_group_security_rule' allows ingress access to port 22 from network security group oci_core_network_security_group.app which is exposed to unrestricted SSH access (0.0.0.0/0 -> oci_core_network_security_group.bastion -> oci_core_network_security_group.app -> oci_core_network_security_group.db)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 66},
+					},
+				},
+				{
+					Rule:    NewOCINetworkSecurityGroupSSHRule(),
+					Message: "OCI Security Group rule 'oci_core_network_security_group_security_rule' allows ingress access to port 22 from network security group oci_core_network_security_group.bastion which is exposed to unrestricted SSH access (0.0.0.0/0 -> oci_core_network_security_group.bastion -> oci_core_network_security_group.app)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 17, Column: 1},
+						End:      hcl.Pos{Line: 17, Column: 67},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewOCINetworkSecurityGroupSSHRule()
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/joelp172/tflint-ruleset-oci/rules/cidr"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCISubnetCIDROverlapRule checks if OCI subnets within the same VCN have overlapping CIDR blocks
+type OCISubnetCIDROverlapRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCISubnetCIDROverlapRule returns a new rule
+func NewOCISubnetCIDROverlapRule() *OCISubnetCIDROverlapRule {
+	return &OCISubnetCIDROverlapRule{}
+}
+
+// Name returns the rule name
+func (r *OCISubnetCIDROverlapRule) Name() string {
+	return "oci_subnet_cidr_overlap"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCISubnetCIDROverlapRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCISubnetCIDROverlapRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCISubnetCIDROverlapRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Network/Tasks/Overview_of_VCNs_and_Subnets.htm"
+}
+
+// Check checks if OCI subnets referencing the same VCN have overlapping CIDR blocks
+func (r *OCISubnetCIDROverlapRule) Check(runner tflint.Runner) error {
+	subnets, err := subnetCIDRs(runner)
+	if err != nil {
+		return err
+	}
+
+	for i, subnet := range subnets {
+		// Blocks with host bits set are reported by oci_subnet_cidr_within_vcn
+		if subnet.vcn == "" || !subnet.canonical {
+			continue
+		}
+		addr := subnet.resource.Labels[0]
+
+		// Report each overlapping pair once, on the later subnet
+		for _, other := range subnets[:i] {
+			if other.vcn != subnet.vcn || !other.canonical || !cidr.Overlaps(subnet.prefix, other.prefix) {
+				continue
+			}
+
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Subnet '%s' CIDR block %s overlaps %s (%s) in the same VCN", addr, subnet.prefix, resourceAddress(other.resource.Labels), other.prefix),
+				subnet.attr.Expr.Range(),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCISubnetCIDROverlap(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "overlapping subnets in the same VCN",
+			Content: `
+resource "oci_core_subnet" "app" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "10.0.0.0/23"
+}
+
+resource "oci_core_subnet" "db" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "10.0.1.0/24"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetCIDROverlapRule(),
+					Message: "OCI Subnet 'oci_core_subnet' CIDR block 10.0.1.0/24 overlaps oci_core_subnet.app (10.0.0.0/23) in the same VCN",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 16},
+						End:      hcl.Pos{Line: 9, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "disjoint subnets in the same VCN",
+			Content: `
+resource "oci_core_subnet" "app" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "10.0.0.0/24"
+}
+
+resource "oci_core_subnet" "db" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "10.0.1.0/24"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "overlapping subnets in different VCNs",
+			Content: `
+resource "oci_core_subnet" "app" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "10.0.0.0/24"
+}
+
+resource "oci_core_subnet" "db" {
+  vcn_id     = oci_core_vcn.other.id
+  cidr_block = "10.0.0.0/24"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCISubnetCIDROverlapRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/joelp172/tflint-ruleset-oci/rules/cidr"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCISubnetCIDRWithinVCNRule checks if OCI VCN and subnet CIDR blocks are valid and if subnet CIDR blocks lie within the CIDR blocks of their VCN
+type OCISubnetCIDRWithinVCNRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCISubnetCIDRWithinVCNRule returns a new rule
+func NewOCISubnetCIDRWithinVCNRule() *OCISubnetCIDRWithinVCNRule {
+	return &OCISubnetCIDRWithinVCNRule{}
+}
+
+// Name returns the rule name
+func (r *OCISubnetCIDRWithinVCNRule) Name() string {
+	return "oci_subnet_cidr_within_vcn"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCISubnetCIDRWithinVCNRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCISubnetCIDRWithinVCNRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCISubnetCIDRWithinVCNRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Network/Tasks/Overview_of_VCNs_and_Subnets.htm"
+}
+
+// Check checks if VCN and subnet CIDR blocks have no host bits set, and if each OCI subnet CIDR block lies within
+// the CIDR blocks of the VCN referenced by vcn_id
+func (r *OCISubnetCIDRWithinVCNRule) Check(runner tflint.Runner) error {
+	if err := r.checkVCNs(runner); err != nil {
+		return err
+	}
+
+	vcns, err := vcnAddressSpaces(runner)
+	if err != nil {
+		return err
+	}
+
+	subnets, err := subnetCIDRs(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range subnets {
+		addr := subnet.resource.Labels[0]
+
+		if !subnet.canonical {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Subnet '%s' CIDR block %s has host bits set, use %s", addr, subnet.block, subnet.prefix),
+				subnet.attr.Expr.Range(),
+			)
+			continue
+		}
+
+		space, exists := vcns[subnet.vcn]
+		if !exists {
+			continue
+		}
+
+		within := false
+		for _, block := range space {
+			if cidr.Contains(block, subnet.prefix) {
+				within = true
+				break
+			}
+		}
+
+		if !within {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Subnet '%s' CIDR block %s is not within the CIDR blocks of its VCN (%s)", addr, subnet.prefix, formatPrefixes(space)),
+				subnet.attr.Expr.Range(),
+			)
+		}
+	}
+	return nil
+}
+
+// checkVCNs reports VCN CIDR blocks with host bits set
+func (r *OCISubnetCIDRWithinVCNRule) checkVCNs(runner tflint.Runner) error {
+	vcns, err := runner.GetResourceContent("oci_core_vcn", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "cidr_block"},
+			{Name: "cidr_blocks"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, vcn := range vcns.Blocks {
+		addr := vcn.Labels[0]
+
+		for _, name := range []string{"cidr_block", "cidr_blocks"} {
+			attr, exists := vcn.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			var blocks []string
+			if name == "cidr_block" {
+				var block string
+				if err := runner.EvaluateExpr(attr.Expr, &block, nil); err != nil {
+					continue
+				}
+				blocks = []string{block}
+			} else if err := runner.EvaluateExpr(attr.Expr, &blocks, nil); err != nil {
+				continue
+			}
+
+			for _, block := range blocks {
+				prefix, err := cidr.Parse(block)
+				if err != nil {
+					continue
+				}
+				if _, err := cidr.ParseCanonical(block); err != nil {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("OCI VCN '%s' CIDR block %s has host bits set, use %s", addr, block, prefix),
+						attr.Expr.Range(),
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
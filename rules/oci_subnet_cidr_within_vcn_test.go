@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCISubnetCIDRWithinVCN(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "subnet outside VCN CIDR block",
+			Content: `
+resource "oci_core_vcn" "main" {
+  cidr_blocks = ["10.0.0.0/16"]
+}
+
+resource "oci_core_subnet" "app" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "10.1.0.0/24"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetCIDRWithinVCNRule(),
+					Message: "OCI Subnet 'oci_core_subnet' CIDR block 10.1.0.0/24 is not within the CIDR blocks of its VCN (10.0.0.0/16)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 16},
+						End:      hcl.Pos{Line: 8, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "subnet larger than VCN CIDR block",
+			Content: `
+resource "oci_core_vcn" "main" {
+  cidr_block = "10.0.0.0/24"
+}
+
+resource "oci_core_subnet" "app" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "10.0.0.0/16"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetCIDRWithinVCNRule(),
+					Message: "OCI Subnet 'oci_core_subnet' CIDR block 10.0.0.0/16 is not within the CIDR blocks of its VCN (10.0.0.0/24)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 16},
+						End:      hcl.Pos{Line: 8, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "subnet within secondary VCN CIDR block",
+			Content: `
+resource "oci_core_vcn" "main" {
+  cidr_blocks = ["10.0.0.0/16", "172.16.0.0/16"]
+}
+
+resource "oci_core_subnet" "app" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "172.16.1.0/24"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "subnet of VCN not declared in the module",
+			Content: `
+resource "oci_core_subnet" "app" {
+  vcn_id     = var.vcn_id
+  cidr_block = "10.1.0.0/24"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "subnet and VCN CIDR blocks with host bits set",
+			Content: `
+resource "oci_core_vcn" "main" {
+  cidr_blocks = ["10.0.0.5/16"]
+}
+
+resource "oci_core_subnet" "app" {
+  vcn_id     = oci_core_vcn.main.id
+  cidr_block = "10.0.1.5/24"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetCIDRWithinVCNRule(),
+					Message: "OCI VCN 'oci_core_vcn' CIDR block 10.0.0.5/16 has host bits set, use 10.0.0.0/16",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 32},
+					},
+				},
+				{
+					Rule:    NewOCISubnetCIDRWithinVCNRule(),
+					Message: "OCI Subnet 'oci_core_subnet' CIDR block 10.0.1.5/24 has host bits set, use 10.0.1.0/24",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 16},
+						End:      hcl.Pos{Line: 8, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOCISubnetCIDRWithinVCNRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
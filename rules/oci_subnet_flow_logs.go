@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCISubnetFlowLogsRule checks if OCI subnets have VCN flow logs enabled
+type OCISubnetFlowLogsRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCISubnetFlowLogsRule returns a new rule
+func NewOCISubnetFlowLogsRule() *OCISubnetFlowLogsRule {
+	return &OCISubnetFlowLogsRule{}
+}
+
+// Name returns the rule name
+func (r *OCISubnetFlowLogsRule) Name() string {
+	return "oci_subnet_flow_logs"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCISubnetFlowLogsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCISubnetFlowLogsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCISubnetFlowLogsRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Network/Concepts/vcn-flow-logs.htm"
+}
+
+// Check checks if every OCI subnet in the module is the source of an oci_logging_log for the flowlogs service
+func (r *OCISubnetFlowLogsRule) Check(runner tflint.Runner) error {
+	logs, err := runner.GetResourceContent("oci_logging_log", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "configuration",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "source",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{
+									{Name: "service"},
+									{Name: "resource"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// Collect the subnets referenced as the source of a flow log
+	logged := map[string]bool{}
+	for _, log := range logs.Blocks {
+		for _, configuration := range log.Body.Blocks {
+			for _, source := range configuration.Body.Blocks {
+				serviceAttr, exists := source.Body.Attributes["service"]
+				if !exists {
+					continue
+				}
+
+				var service string
+				err := runner.EvaluateExpr(serviceAttr.Expr, &service, nil)
+				if err == nil && service != "flowlogs" {
+					continue
+				}
+
+				resourceAttr, exists := source.Body.Attributes["resource"]
+				if !exists {
+					continue
+				}
+
+				for _, name := range referencedResourceNames(resourceAttr.Expr, "oci_core_subnet") {
+					logged[name] = true
+				}
+			}
+		}
+	}
+
+	subnets, err := runner.GetResourceContent("oci_core_subnet", &hclext.BodySchema{}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range subnets.Blocks {
+		addr := resource.Labels[0]
+
+		if !logged[resource.Labels[1]] {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Subnet '%s' does not have VCN flow logs enabled", addr),
+				resource.DefRange,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCISubnetFlowLogs(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "subnet without flow log",
+			Content: `
+resource "oci_core_subnet" "app" {
+  cidr_block = "10.0.1.0/24"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetFlowLogsRule(),
+					Message: "OCI Subnet 'oci_core_subnet' does not have VCN flow logs enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "subnet with flow log",
+			Content: `
+resource "oci_core_subnet" "app" {
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "oci_logging_log" "app_flow" {
+  log_type = "SERVICE"
+  configuration {
+    source {
+      category    = "all"
+      resource    = oci_core_subnet.app.id
+      service     = "flowlogs"
+      source_type = "OCISERVICE"
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "subnet logged by another service",
+			Content: `
+resource "oci_core_subnet" "app" {
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "oci_logging_log" "app" {
+  log_type = "SERVICE"
+  configuration {
+    source {
+      category    = "all"
+      resource    = oci_core_subnet.app.id
+      service     = "objectstorage"
+      source_type = "OCISERVICE"
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetFlowLogsRule(),
+					Message: "OCI Subnet 'oci_core_subnet' does not have VCN flow logs enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "flow log for a different subnet",
+			Content: `
+resource "oci_core_subnet" "app" {
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "oci_core_subnet" "db" {
+  cidr_block = "10.0.2.0/24"
+}
+
+resource "oci_logging_log" "app_flow" {
+  log_type = "SERVICE"
+  configuration {
+    source {
+      category    = "all"
+      resource    = oci_core_subnet.app.id
+      service     = "flowlogs"
+      source_type = "OCISERVICE"
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetFlowLogsRule(),
+					Message: "OCI Subnet 'oci_core_subnet' does not have VCN flow logs enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 32},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOCISubnetFlowLogsRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
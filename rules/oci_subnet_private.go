@@ -0,0 +1,209 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCISubnetPrivateRule checks if OCI subnets marked as private block public IPs, internet ingress and internet gateway routes
+type OCISubnetPrivateRule struct {
+	tflint.DefaultRule
+}
+
+// ociSubnetPrivateRuleConfig is the rule configuration
+type ociSubnetPrivateRuleConfig struct {
+	// PrivateTags mark a subnet as private, in addition to "private" appearing in its name or display_name
+	PrivateTags map[string]string `hclext:"private_tags,optional"`
+}
+
+// NewOCISubnetPrivateRule returns a new rule
+func NewOCISubnetPrivateRule() *OCISubnetPrivateRule {
+	return &OCISubnetPrivateRule{}
+}
+
+// Name returns the rule name
+func (r *OCISubnetPrivateRule) Name() string {
+	return "oci_subnet_private"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCISubnetPrivateRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCISubnetPrivateRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCISubnetPrivateRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Network/Tasks/Overview_of_VCNs_and_Subnets.htm#Public"
+}
+
+// Check checks if private OCI subnets prohibit public IPs and internet ingress, and don't route to an internet gateway
+func (r *OCISubnetPrivateRule) Check(runner tflint.Runner) error {
+	config := &ociSubnetPrivateRuleConfig{
+		PrivateTags: map[string]string{"tier": "private"},
+	}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	subnets, err := runner.GetResourceContent("oci_core_subnet", &hclext.BodySchema{
+		Attributes: append([]hclext.AttributeSchema{
+			{Name: "display_name"},
+			{Name: "prohibit_public_ip_on_vnic"},
+			{Name: "prohibit_internet_ingress"},
+			{Name: "route_table_id"},
+		}, tagAttributeSchemas...),
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// Map the route tables to the private subnets using them, keyed by route table name
+	routeTableSubnets := map[string][]string{}
+	privateSubnets := map[string]bool{}
+
+	for _, resource := range subnets.Blocks {
+		if !r.isPrivate(runner, resource, config) {
+			continue
+		}
+		privateSubnets[resource.Labels[1]] = true
+
+		for _, attribute := range []string{"prohibit_public_ip_on_vnic", "prohibit_internet_ingress"} {
+			r.checkProhibited(runner, resource, attribute)
+		}
+
+		if attr, exists := resource.Body.Attributes["route_table_id"]; exists {
+			for _, name := range referencedResourceNames(attr.Expr, "oci_core_route_table") {
+				routeTableSubnets[name] = append(routeTableSubnets[name], resourceAddress(resource.Labels))
+			}
+		}
+	}
+
+	// Route tables can also be associated with a subnet through a separate attachment
+	attachments, err := runner.GetResourceContent("oci_core_route_table_attachment", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "subnet_id"},
+			{Name: "route_table_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments.Blocks {
+		subnetAttr, exists := attachment.Body.Attributes["subnet_id"]
+		if !exists {
+			continue
+		}
+		routeTableAttr, exists := attachment.Body.Attributes["route_table_id"]
+		if !exists {
+			continue
+		}
+
+		for _, subnet := range referencedResourceNames(subnetAttr.Expr, "oci_core_subnet") {
+			if !privateSubnets[subnet] {
+				continue
+			}
+			for _, name := range referencedResourceNames(routeTableAttr.Expr, "oci_core_route_table") {
+				routeTableSubnets[name] = append(routeTableSubnets[name], "oci_core_subnet."+subnet)
+			}
+		}
+	}
+
+	if len(routeTableSubnets) == 0 {
+		return nil
+	}
+
+	routeTables, err := runner.GetResourceContent("oci_core_route_table", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "route_rules",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "network_entity_id"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range routeTables.Blocks {
+		addr := resource.Labels[0]
+
+		usedBy, exists := routeTableSubnets[resource.Labels[1]]
+		if !exists {
+			continue
+		}
+
+		for _, routeRule := range resource.Body.Blocks {
+			attr, exists := routeRule.Body.Attributes["network_entity_id"]
+			if !exists {
+				continue
+			}
+
+			for _, gateway := range referencedResourceNames(attr.Expr, "oci_core_internet_gateway") {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI Route Table '%s' routes to internet gateway oci_core_internet_gateway.%s but is used by private subnet %s", addr, gateway, strings.Join(usedBy, ", ")),
+					attr.Expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// isPrivate reports whether a subnet is marked as private by its name, display_name or tags
+func (r *OCISubnetPrivateRule) isPrivate(runner tflint.Runner, resource *hclext.Block, config *ociSubnetPrivateRuleConfig) bool {
+	if strings.Contains(strings.ToLower(resource.Labels[1]), "private") {
+		return true
+	}
+
+	if attr, exists := resource.Body.Attributes["display_name"]; exists {
+		var displayName string
+		if err := runner.EvaluateExpr(attr.Expr, &displayName, nil); err == nil && strings.Contains(strings.ToLower(displayName), "private") {
+			return true
+		}
+	}
+
+	return matchesAnyTag(resourceTags(runner, resource.Body), config.PrivateTags)
+}
+
+// checkProhibited checks if a private subnet sets the given prohibit_* attribute to true
+func (r *OCISubnetPrivateRule) checkProhibited(runner tflint.Runner, resource *hclext.Block, attribute string) {
+	addr := resource.Labels[0]
+
+	attr, exists := resource.Body.Attributes[attribute]
+	if !exists {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Subnet '%s' is private but does not set %s to true", addr, attribute),
+			resource.DefRange,
+		)
+		return
+	}
+
+	var prohibited bool
+	if err := runner.EvaluateExpr(attr.Expr, &prohibited, nil); err != nil {
+		// Skip if we can't evaluate the value (likely a variable or reference)
+		return
+	}
+
+	if !prohibited {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("OCI Subnet '%s' is private but does not set %s to true", addr, attribute),
+			attr.Expr.Range(),
+		)
+	}
+}
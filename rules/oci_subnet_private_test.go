@@ -0,0 +1,230 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCISubnetPrivate(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "private subnet without prohibit attributes",
+			Content: `
+resource "oci_core_subnet" "private" {
+  cidr_block = "10.0.1.0/24"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetPrivateRule(),
+					Message: "OCI Subnet 'oci_core_subnet' is private but does not set prohibit_public_ip_on_vnic to true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+				{
+					Rule:    NewOCISubnetPrivateRule(),
+					Message: "OCI Subnet 'oci_core_subnet' is private but does not set prohibit_internet_ingress to true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "subnet with private display_name allowing public IPs",
+			Content: `
+resource "oci_core_subnet" "app" {
+  display_name               = "app-private"
+  prohibit_public_ip_on_vnic = false
+  prohibit_internet_ingress  = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetPrivateRule(),
+					Message: "OCI Subnet 'oci_core_subnet' is private but does not set prohibit_public_ip_on_vnic to true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 32},
+						End:      hcl.Pos{Line: 4, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "subnet tagged private with the default tag",
+			Content: `
+resource "oci_core_subnet" "app" {
+  prohibit_public_ip_on_vnic = true
+  prohibit_internet_ingress  = false
+  freeform_tags = {
+    tier = "private"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetPrivateRule(),
+					Message: "OCI Subnet 'oci_core_subnet' is private but does not set prohibit_internet_ingress to true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 32},
+						End:      hcl.Pos{Line: 4, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "subnet tagged private with configured tags",
+			Content: `
+resource "oci_core_subnet" "app" {
+  prohibit_public_ip_on_vnic = true
+  defined_tags = {
+    "Network.exposure" = "internal"
+  }
+}`,
+			Config: `
+rule "oci_subnet_private" {
+  enabled      = true
+  private_tags = {
+    "Network.exposure" = "internal"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetPrivateRule(),
+					Message: "OCI Subnet 'oci_core_subnet' is private but does not set prohibit_internet_ingress to true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "public subnet",
+			Content: `
+resource "oci_core_subnet" "public" {
+  prohibit_public_ip_on_vnic = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private subnet routing to an internet gateway",
+			Content: `
+resource "oci_core_route_table" "private" {
+  route_rules {
+    destination       = "0.0.0.0/0"
+    network_entity_id = oci_core_internet_gateway.igw.id
+  }
+}
+
+resource "oci_core_subnet" "private" {
+  prohibit_public_ip_on_vnic = true
+  prohibit_internet_ingress  = true
+  route_table_id             = oci_core_route_table.private.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetPrivateRule(),
+					Message: "OCI Route Table 'oci_core_route_table' routes to internet gateway oci_core_internet_gateway.igw but is used by private subnet oci_core_subnet.private",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 25},
+						End:      hcl.Pos{Line: 5, Column: 57},
+					},
+				},
+			},
+		},
+		{
+			Name: "private subnet attached to an internet gateway route table",
+			Content: `
+resource "oci_core_route_table" "shared" {
+  route_rules {
+    destination       = "0.0.0.0/0"
+    network_entity_id = oci_core_internet_gateway.igw.id
+  }
+}
+
+resource "oci_core_subnet" "private" {
+  prohibit_public_ip_on_vnic = true
+  prohibit_internet_ingress  = true
+}
+
+resource "oci_core_route_table_attachment" "private" {
+  subnet_id      = oci_core_subnet.private.id
+  route_table_id = oci_core_route_table.shared.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCISubnetPrivateRule(),
+					Message: "OCI Route Table 'oci_core_route_table' routes to internet gateway oci_core_internet_gateway.igw but is used by private subnet oci_core_subnet.private",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 25},
+						End:      hcl.Pos{Line: 5, Column: 57},
+					},
+				},
+			},
+		},
+		{
+			Name: "private subnet routing to a NAT gateway",
+			Content: `
+resource "oci_core_route_table" "private" {
+  route_rules {
+    destination       = "0.0.0.0/0"
+    network_entity_id = oci_core_nat_gateway.nat.id
+  }
+}
+
+resource "oci_core_subnet" "private" {
+  prohibit_public_ip_on_vnic = true
+  prohibit_internet_ingress  = true
+  route_table_id             = oci_core_route_table.private.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "public subnet routing to an internet gateway",
+			Content: `
+resource "oci_core_route_table" "public" {
+  route_rules {
+    destination       = "0.0.0.0/0"
+    network_entity_id = oci_core_internet_gateway.igw.id
+  }
+}
+
+resource "oci_core_subnet" "public" {
+  route_table_id = oci_core_route_table.public.id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCISubnetPrivateRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tc.Content}
+			if tc.Config != "" {
+				files[".tflint.hcl"] = tc.Config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
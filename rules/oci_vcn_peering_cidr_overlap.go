@@ -0,0 +1,201 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIVCNPeeringCIDROverlapRule checks if OCI VCNs connected through a DRG or local peering have overlapping address space
+type OCIVCNPeeringCIDROverlapRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIVCNPeeringCIDROverlapRule returns a new rule
+func NewOCIVCNPeeringCIDROverlapRule() *OCIVCNPeeringCIDROverlapRule {
+	return &OCIVCNPeeringCIDROverlapRule{}
+}
+
+// Name returns the rule name
+func (r *OCIVCNPeeringCIDROverlapRule) Name() string {
+	return "oci_vcn_peering_cidr_overlap"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIVCNPeeringCIDROverlapRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIVCNPeeringCIDROverlapRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIVCNPeeringCIDROverlapRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Network/Tasks/localVCNpeering.htm"
+}
+
+// vcnAttachment is a VCN attached to a DRG
+type vcnAttachment struct {
+	resource *hclext.Block
+	vcn      string
+	// vcnRange is the range of the expression referencing the VCN
+	vcnRange hcl.Range
+}
+
+// Check checks if VCNs attached to the same DRG, or peered through local peering gateways, have overlapping CIDR blocks
+func (r *OCIVCNPeeringCIDROverlapRule) Check(runner tflint.Runner) error {
+	vcns, err := vcnAddressSpaces(runner)
+	if err != nil {
+		return err
+	}
+
+	if err := r.checkDRGAttachments(runner, vcns); err != nil {
+		return err
+	}
+	return r.checkLocalPeeringGateways(runner, vcns)
+}
+
+// checkDRGAttachments reports VCNs attached to the same DRG with overlapping CIDR blocks
+func (r *OCIVCNPeeringCIDROverlapRule) checkDRGAttachments(runner tflint.Runner, vcns map[string][]netip.Prefix) error {
+	attachments, err := runner.GetResourceContent("oci_core_drg_attachment", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "drg_id"},
+			{Name: "vcn_id"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "network_details",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "id"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	var drgs []string
+	attachedVCNs := map[string][]vcnAttachment{}
+	for _, attachment := range attachments.Blocks {
+		drgAttr, exists := attachment.Body.Attributes["drg_id"]
+		if !exists {
+			continue
+		}
+
+		// Group by the referenced oci_core_drg, or by the literal DRG OCID
+		var drg string
+		if names := referencedResourceNames(drgAttr.Expr, "oci_core_drg"); len(names) > 0 {
+			drg = "oci_core_drg." + names[0]
+		} else if err := runner.EvaluateExpr(drgAttr.Expr, &drg, nil); err != nil {
+			continue
+		}
+
+		// The VCN is set either by vcn_id or by network_details.id
+		vcnAttrs := []*hclext.Attribute{attachment.Body.Attributes["vcn_id"]}
+		for _, details := range attachment.Body.Blocks {
+			vcnAttrs = append(vcnAttrs, details.Body.Attributes["id"])
+		}
+
+		for _, attr := range vcnAttrs {
+			if attr == nil {
+				continue
+			}
+			for _, name := range referencedResourceNames(attr.Expr, "oci_core_vcn") {
+				if _, exists := attachedVCNs[drg]; !exists {
+					drgs = append(drgs, drg)
+				}
+				attachedVCNs[drg] = append(attachedVCNs[drg], vcnAttachment{resource: attachment, vcn: name, vcnRange: attr.Expr.Range()})
+			}
+		}
+	}
+
+	for _, drg := range drgs {
+		attached := attachedVCNs[drg]
+		for i, attachment := range attached {
+			addr := attachment.resource.Labels[0]
+
+			// Report each overlapping pair once, on the later attachment
+			for _, other := range attached[:i] {
+				if other.vcn == attachment.vcn {
+					continue
+				}
+
+				block, otherBlock, overlap := addressSpacesOverlap(vcns[attachment.vcn], vcns[other.vcn])
+				if !overlap {
+					continue
+				}
+
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("OCI DRG Attachment '%s' attaches oci_core_vcn.%s (%s) which overlaps oci_core_vcn.%s (%s) on the same DRG", addr, attachment.vcn, block, other.vcn, otherBlock),
+					attachment.vcnRange,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// checkLocalPeeringGateways reports local peering gateways connecting VCNs with overlapping CIDR blocks
+func (r *OCIVCNPeeringCIDROverlapRule) checkLocalPeeringGateways(runner tflint.Runner, vcns map[string][]netip.Prefix) error {
+	gateways, err := runner.GetResourceContent("oci_core_local_peering_gateway", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "vcn_id"},
+			{Name: "peer_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the VCN of each local peering gateway
+	gatewayVCNs := map[string]string{}
+	for _, gateway := range gateways.Blocks {
+		if attr, exists := gateway.Body.Attributes["vcn_id"]; exists {
+			if names := referencedResourceNames(attr.Expr, "oci_core_vcn"); len(names) > 0 {
+				gatewayVCNs[gateway.Labels[1]] = names[0]
+			}
+		}
+	}
+
+	for _, gateway := range gateways.Blocks {
+		addr := gateway.Labels[0]
+
+		peerAttr, exists := gateway.Body.Attributes["peer_id"]
+		if !exists {
+			continue
+		}
+
+		vcn, exists := gatewayVCNs[gateway.Labels[1]]
+		if !exists {
+			continue
+		}
+
+		for _, peer := range referencedResourceNames(peerAttr.Expr, "oci_core_local_peering_gateway") {
+			peerVCN, exists := gatewayVCNs[peer]
+			if !exists || peerVCN == vcn {
+				continue
+			}
+
+			block, peerBlock, overlap := addressSpacesOverlap(vcns[vcn], vcns[peerVCN])
+			if !overlap {
+				continue
+			}
+
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Local Peering Gateway '%s' peers oci_core_vcn.%s (%s) with overlapping oci_core_vcn.%s (%s)", addr, vcn, block, peerVCN, peerBlock),
+				peerAttr.Expr.Range(),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIVCNPeeringCIDROverlap(t *testing.T) {
+	vcns := `
+resource "oci_core_vcn" "hub" {
+  cidr_blocks = ["10.0.0.0/16"]
+}
+
+resource "oci_core_vcn" "spoke" {
+  cidr_blocks = ["10.0.128.0/20"]
+}
+
+resource "oci_core_vcn" "other" {
+  cidr_blocks = ["10.1.0.0/16"]
+}
+`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "overlapping VCNs attached to the same DRG",
+			Content: vcns + `
+resource "oci_core_drg_attachment" "hub" {
+  drg_id = oci_core_drg.main.id
+  vcn_id = oci_core_vcn.hub.id
+}
+
+resource "oci_core_drg_attachment" "spoke" {
+  drg_id = oci_core_drg.main.id
+  network_details {
+    id   = oci_core_vcn.spoke.id
+    type = "VCN"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIVCNPeeringCIDROverlapRule(),
+					Message: "OCI DRG Attachment 'oci_core_drg_attachment' attaches oci_core_vcn.spoke (10.0.128.0/20) which overlaps oci_core_vcn.hub (10.0.0.0/16) on the same DRG",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 22, Column: 12},
+						End:      hcl.Pos{Line: 22, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "disjoint VCNs attached to the same DRG",
+			Content: vcns + `
+resource "oci_core_drg_attachment" "hub" {
+  drg_id = oci_core_drg.main.id
+  vcn_id = oci_core_vcn.hub.id
+}
+
+resource "oci_core_drg_attachment" "other" {
+  drg_id = oci_core_drg.main.id
+  vcn_id = oci_core_vcn.other.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "overlapping VCNs attached to different DRGs",
+			Content: vcns + `
+resource "oci_core_drg_attachment" "hub" {
+  drg_id = oci_core_drg.main.id
+  vcn_id = oci_core_vcn.hub.id
+}
+
+resource "oci_core_drg_attachment" "spoke" {
+  drg_id = oci_core_drg.dr.id
+  vcn_id = oci_core_vcn.spoke.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "local peering between overlapping VCNs",
+			Content: vcns + `
+resource "oci_core_local_peering_gateway" "hub" {
+  vcn_id = oci_core_vcn.hub.id
+}
+
+resource "oci_core_local_peering_gateway" "spoke" {
+  vcn_id  = oci_core_vcn.spoke.id
+  peer_id = oci_core_local_peering_gateway.hub.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIVCNPeeringCIDROverlapRule(),
+					Message: "OCI Local Peering Gateway 'oci_core_local_peering_gateway' peers oci_core_vcn.spoke (10.0.128.0/20) with overlapping oci_core_vcn.hub (10.0.0.0/16)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 20, Column: 13},
+						End:      hcl.Pos{Line: 20, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "local peering between disjoint VCNs",
+			Content: vcns + `
+resource "oci_core_local_peering_gateway" "hub" {
+  vcn_id = oci_core_vcn.hub.id
+}
+
+resource "oci_core_local_peering_gateway" "other" {
+  vcn_id  = oci_core_vcn.other.id
+  peer_id = oci_core_local_peering_gateway.hub.id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIVCNPeeringCIDROverlapRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OCIVolumeAttachmentInTransitEncryptionRule checks if OCI paravirtualized volume attachments have in-transit data encryption enabled
+type OCIVolumeAttachmentInTransitEncryptionRule struct {
+	tflint.DefaultRule
+}
+
+// NewOCIVolumeAttachmentInTransitEncryptionRule returns a new rule
+func NewOCIVolumeAttachmentInTransitEncryptionRule() *OCIVolumeAttachmentInTransitEncryptionRule {
+	return &OCIVolumeAttachmentInTransitEncryptionRule{}
+}
+
+// Name returns the rule name
+func (r *OCIVolumeAttachmentInTransitEncryptionRule) Name() string {
+	return "oci_volume_attachment_in_transit_encryption"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *OCIVolumeAttachmentInTransitEncryptionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *OCIVolumeAttachmentInTransitEncryptionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *OCIVolumeAttachmentInTransitEncryptionRule) Link() string {
+	return "https://docs.oracle.com/en-us/iaas/Content/Block/Concepts/overview.htm#BlockVolumeEncryption"
+}
+
+// Check checks if OCI paravirtualized volume attachments have in-transit data encryption enabled
+func (r *OCIVolumeAttachmentInTransitEncryptionRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("oci_core_volume_attachment", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "attachment_type"},
+			{Name: "is_pv_encryption_in_transit_enabled"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		addr := resource.Labels[0]
+
+		typeAttr, exists := resource.Body.Attributes["attachment_type"]
+		if !exists {
+			continue
+		}
+
+		var attachmentType string
+		err := runner.EvaluateExpr(typeAttr.Expr, &attachmentType, nil)
+		if err != nil {
+			// Skip if we can't evaluate the attachment type (likely a variable or reference)
+			continue
+		}
+
+		// In-transit encryption only applies to paravirtualized attachments
+		if !strings.EqualFold(attachmentType, "paravirtualized") {
+			continue
+		}
+
+		attr, exists := resource.Body.Attributes["is_pv_encryption_in_transit_enabled"]
+		if !exists {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Volume Attachment '%s' does not have in-transit data encryption enabled", addr),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		if enabled, ok := evaluateBool(runner, attr.Expr); ok && !enabled {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("OCI Volume Attachment '%s' does not have in-transit data encryption enabled", addr),
+				attr.Expr.Range(),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_OCIVolumeAttachmentInTransitEncryption(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "paravirtualized attachment without encryption attribute",
+			Content: `
+resource "oci_core_volume_attachment" "data" {
+  attachment_type = "paravirtualized"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIVolumeAttachmentInTransitEncryptionRule(),
+					Message: "OCI Volume Attachment 'oci_core_volume_attachment' does not have in-transit data encryption enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "paravirtualized attachment with encryption disabled",
+			Content: `
+resource "oci_core_volume_attachment" "data" {
+  attachment_type                     = "paravirtualized"
+  is_pv_encryption_in_transit_enabled = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewOCIVolumeAttachmentInTransitEncryptionRule(),
+					Message: "OCI Volume Attachment 'oci_core_volume_attachment' does not have in-transit data encryption enabled",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 41},
+						End:      hcl.Pos{Line: 4, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "paravirtualized attachment with encryption enabled",
+			Content: `
+resource "oci_core_volume_attachment" "data" {
+  attachment_type                     = "paravirtualized"
+  is_pv_encryption_in_transit_enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "iscsi attachment",
+			Content: `
+resource "oci_core_volume_attachment" "data" {
+  attachment_type = "iscsi"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "values from variables without defaults",
+			Content: `
+variable "t" {}
+variable "encrypted" {}
+
+resource "oci_core_volume_attachment" "data" {
+  attachment_type = var.t
+}
+
+resource "oci_core_volume_attachment" "logs" {
+  attachment_type                     = "paravirtualized"
+  is_pv_encryption_in_transit_enabled = var.encrypted
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	rule := NewOCIVolumeAttachmentInTransitEncryptionRule()
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tc.Content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, tc.Expected, runner.Issues)
+		})
+	}
+}
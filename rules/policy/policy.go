@@ -0,0 +1,550 @@
+// Package policy parses statements of the OCI IAM policy language, e.g.
+//
+//	Allow group NetworkAdmins to manage virtual-network-family in compartment Networks where request.region = 'iad'
+package policy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Statement actions
+const (
+	ActionAllow   = "allow"
+	ActionEndorse = "endorse"
+	ActionAdmit   = "admit"
+	ActionDefine  = "define"
+)
+
+// Subject kinds
+const (
+	SubjectGroup        = "group"
+	SubjectDynamicGroup = "dynamic-group"
+	SubjectService      = "service"
+	SubjectAnyUser      = "any-user"
+	SubjectAnyGroup     = "any-group"
+)
+
+// Location kinds
+const (
+	LocationTenancy     = "tenancy"
+	LocationCompartment = "compartment"
+	LocationAnyTenancy  = "any-tenancy"
+)
+
+// Verbs are the verbs of the policy language, from least to most permissive
+var Verbs = []string{"inspect", "read", "use", "manage"}
+
+// Statement is a parsed policy statement. Keywords are lower-cased, names keep their case.
+type Statement struct {
+	Action   string
+	Subjects []Subject
+	// Verb is empty when the statement grants a list of Permissions
+	Verb        string
+	Permissions []string
+	// ResourceType is a resource family (e.g. "all-resources") or an individual resource type, empty for Permissions
+	ResourceType string
+	Location     Location
+	// Where is nil when the statement has no conditions
+	Where *Where
+	// Alias and OCID are set by Define statements
+	Alias string
+	OCID  string
+}
+
+// Subject is who a statement grants access to
+type Subject struct {
+	Kind string
+	// Name is the group, dynamic group or service name, or an OCID when ByID is set. Empty for any-user and any-group.
+	Name string
+	ByID bool
+	// Tenancy is the tenancy alias of an Admit subject, e.g. "group Admins of tenancy Acme"
+	Tenancy string
+}
+
+// Location is where a statement applies
+type Location struct {
+	Kind string
+	// Name is the compartment name or path (e.g. "Apps:Prod"), the OCID when ByID is set, or the tenancy alias of Endorse and Admit statements
+	Name string
+	ByID bool
+}
+
+// Where is the conditions clause of a statement
+type Where struct {
+	// Quantifier is "any" or "all" for condition lists, empty for a single condition
+	Quantifier string
+	Conditions []Condition
+}
+
+// Condition is a single condition, e.g. request.permission = 'BUCKET_DELETE', or a nested condition list
+type Condition struct {
+	Variable string
+	Operator string
+	// Values holds both bounds of between and every value of an in list, e.g. in ('monday', 'tuesday')
+	Values []string
+	// Group is set instead of Variable for a nested "any {...}" or "all {...}" condition list
+	Group *Where
+}
+
+// Error is a syntax error in a policy statement
+type Error struct {
+	Statement string
+	Message   string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Parse parses a policy statement
+func Parse(statement string) (*Statement, error) {
+	tokens, err := tokenize(statement)
+	if err != nil {
+		return nil, &Error{Statement: statement, Message: err.Error()}
+	}
+
+	p := &parser{statement: statement, tokens: tokens}
+	s, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type parser struct {
+	statement string
+	tokens    []string
+	pos       int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return &Error{Statement: p.statement, Message: fmt.Sprintf(format, args...)}
+}
+
+// peek returns the next token lower-cased, or an empty string at the end of the statement
+func (p *parser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return strings.ToLower(p.tokens[p.pos])
+}
+
+// next consumes and returns the next token as written
+func (p *parser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	return token
+}
+
+// expect consumes the keyword or returns an error describing what was found instead
+func (p *parser) expect(keyword string) error {
+	if p.peek() != keyword {
+		return p.errorf("expected '%s' %s", keyword, p.found())
+	}
+	p.pos++
+	return nil
+}
+
+// found describes the next token for error messages
+func (p *parser) found() string {
+	if p.pos >= len(p.tokens) {
+		return "at the end of the statement"
+	}
+	return fmt.Sprintf("but found '%s'", p.tokens[p.pos])
+}
+
+// name consumes a name, failing at the end of the statement or on punctuation
+func (p *parser) name(what string) (string, error) {
+	token := p.peek()
+	if token == "" || token == "," || token == "{" || token == "}" || token == "(" || token == ")" {
+		return "", p.errorf("expected %s %s", what, p.found())
+	}
+	return p.next(), nil
+}
+
+func (p *parser) parse() (*Statement, error) {
+	s := &Statement{Action: p.peek()}
+
+	switch s.Action {
+	case ActionAllow, ActionEndorse, ActionAdmit:
+		p.pos++
+	case ActionDefine:
+		p.pos++
+		return s, p.parseDefine(s)
+	default:
+		return nil, p.errorf("statement must start with Allow, Endorse, Admit or Define %s", p.found())
+	}
+
+	if err := p.parseSubjects(s); err != nil {
+		return nil, err
+	}
+	if err := p.expect("to"); err != nil {
+		return nil, err
+	}
+	if err := p.parseGrant(s); err != nil {
+		return nil, err
+	}
+	if err := p.expect("in"); err != nil {
+		return nil, err
+	}
+	if err := p.parseLocation(s); err != nil {
+		return nil, err
+	}
+
+	if p.peek() == "where" {
+		p.pos++
+		where, err := p.parseWhere()
+		if err != nil {
+			return nil, err
+		}
+		s.Where = where
+	}
+
+	if p.pos < len(p.tokens) {
+		return nil, p.errorf("unexpected '%s' after the end of the statement", p.tokens[p.pos])
+	}
+	return s, nil
+}
+
+// parseDefine parses "Define tenancy|group|dynamic-group <alias> as <ocid>"
+func (p *parser) parseDefine(s *Statement) error {
+	switch p.peek() {
+	case LocationTenancy, SubjectGroup, SubjectDynamicGroup:
+		p.pos++
+	default:
+		return p.errorf("expected 'tenancy', 'group' or 'dynamic-group' %s", p.found())
+	}
+
+	alias, err := p.name("an alias")
+	if err != nil {
+		return err
+	}
+	s.Alias = alias
+
+	if err := p.expect("as"); err != nil {
+		return err
+	}
+
+	ocid, err := p.name("an OCID")
+	if err != nil {
+		return err
+	}
+	s.OCID = ocid
+
+	if p.pos < len(p.tokens) {
+		return p.errorf("unexpected '%s' after the end of the statement", p.tokens[p.pos])
+	}
+	return nil
+}
+
+// parseSubjects parses a comma separated list of subjects, e.g. "group A, dynamic-group id ocid1..."
+func (p *parser) parseSubjects(s *Statement) error {
+	kind := ""
+	for {
+		subject := Subject{}
+
+		switch token := p.peek(); token {
+		case SubjectAnyUser, SubjectAnyGroup:
+			p.pos++
+			subject.Kind = token
+		case SubjectGroup, SubjectDynamicGroup, SubjectService:
+			p.pos++
+			kind = token
+			fallthrough
+		default:
+			// A bare name continues a list of the previous kind, e.g. "group A, B"
+			if kind == "" {
+				return p.errorf("expected a subject (group, dynamic-group, service, any-user or any-group) %s", p.found())
+			}
+			subject.Kind = kind
+
+			if p.peek() == "id" {
+				p.pos++
+				subject.ByID = true
+			}
+
+			name, err := p.name(fmt.Sprintf("a %s name", kind))
+			if err != nil {
+				return err
+			}
+			subject.Name = name
+		}
+
+		if s.Action == ActionAdmit && p.peek() == "of" {
+			p.pos++
+			if err := p.expect(LocationTenancy); err != nil {
+				return err
+			}
+			tenancy, err := p.name("a tenancy alias")
+			if err != nil {
+				return err
+			}
+			subject.Tenancy = tenancy
+		}
+
+		s.Subjects = append(s.Subjects, subject)
+
+		if p.peek() != "," {
+			return nil
+		}
+		p.pos++
+	}
+}
+
+// parseGrant parses "<verb> <resource-type>" or "{PERMISSION, ...}"
+func (p *parser) parseGrant(s *Statement) error {
+	if p.peek() == "{" {
+		p.pos++
+		for {
+			permission, err := p.name("a permission")
+			if err != nil {
+				return err
+			}
+			s.Permissions = append(s.Permissions, permission)
+
+			switch p.peek() {
+			case ",":
+				p.pos++
+			case "}":
+				p.pos++
+				return nil
+			default:
+				return p.errorf("expected ',' or '}' in the permission list %s", p.found())
+			}
+		}
+	}
+
+	verb := p.peek()
+	if !isVerb(verb) {
+		if verb == "" {
+			return p.errorf("expected a verb (inspect, read, use or manage) %s", p.found())
+		}
+		return p.errorf("unknown verb '%s', expected inspect, read, use or manage", p.tokens[p.pos])
+	}
+	p.pos++
+	s.Verb = verb
+
+	if p.peek() == "in" {
+		return p.errorf("expected a resource type after '%s'", verb)
+	}
+	resourceType, err := p.name("a resource type")
+	if err != nil {
+		return err
+	}
+	s.ResourceType = strings.ToLower(resourceType)
+	return nil
+}
+
+// parseLocation parses "tenancy", "compartment [id] <name>", or for Endorse and Admit "tenancy <alias>" and "any-tenancy"
+func (p *parser) parseLocation(s *Statement) error {
+	switch token := p.peek(); token {
+	case LocationTenancy:
+		p.pos++
+		s.Location.Kind = token
+		if s.Action != ActionAllow && p.peek() != "" && p.peek() != "where" {
+			s.Location.Name = p.next()
+		}
+		return nil
+	case LocationAnyTenancy:
+		p.pos++
+		s.Location.Kind = token
+		return nil
+	case LocationCompartment:
+		p.pos++
+		s.Location.Kind = token
+		if p.peek() == "id" {
+			p.pos++
+			s.Location.ByID = true
+		}
+		name, err := p.name("a compartment name")
+		if err != nil {
+			return err
+		}
+		s.Location.Name = name
+		return nil
+	default:
+		return p.errorf("expected 'tenancy' or 'compartment' %s", p.found())
+	}
+}
+
+// parseWhere parses a condition or "any {...}" / "all {...}" condition list
+func (p *parser) parseWhere() (*Where, error) {
+	if quantifier := p.peek(); quantifier == "any" || quantifier == "all" {
+		p.pos++
+		return p.parseConditionList(quantifier)
+	}
+
+	condition, err := p.parseCondition()
+	if err != nil {
+		return nil, err
+	}
+	return &Where{Conditions: []Condition{condition}}, nil
+}
+
+// parseConditionList parses the "{<condition>, ...}" following an any or all quantifier
+func (p *parser) parseConditionList(quantifier string) (*Where, error) {
+	where := &Where{Quantifier: quantifier}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for {
+		condition, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		where.Conditions = append(where.Conditions, condition)
+
+		switch p.peek() {
+		case ",":
+			p.pos++
+		case "}":
+			p.pos++
+			return where, nil
+		default:
+			return nil, p.errorf("expected ',' or '}' in the condition list %s", p.found())
+		}
+	}
+}
+
+// parseCondition parses "<variable> <operator> <value>", e.g. request.time between '09:00' and '17:00'
+func (p *parser) parseCondition() (Condition, error) {
+	condition := Condition{}
+
+	if quantifier := p.peek(); quantifier == "any" || quantifier == "all" {
+		p.pos++
+		group, err := p.parseConditionList(quantifier)
+		condition.Group = group
+		return condition, err
+	}
+
+	variable, err := p.name("a condition variable")
+	if err != nil {
+		return condition, err
+	}
+	lower := strings.ToLower(variable)
+	if !strings.HasPrefix(lower, "request.") && !strings.HasPrefix(lower, "target.") {
+		return condition, p.errorf("unknown condition variable '%s', variables start with 'request.' or 'target.'", variable)
+	}
+	condition.Variable = lower
+
+	operator := p.peek()
+	switch operator {
+	case "=", "!=", "before", "after":
+		p.pos++
+	case "in":
+		p.pos++
+		if p.peek() == "(" {
+			p.pos++
+			values, err := p.parseValueList()
+			condition.Operator = operator
+			condition.Values = values
+			return condition, err
+		}
+	case "between":
+		p.pos++
+		first, err := p.name("a value")
+		if err != nil {
+			return condition, err
+		}
+		if err := p.expect("and"); err != nil {
+			return condition, err
+		}
+		condition.Values = append(condition.Values, first)
+	default:
+		return condition, p.errorf("expected an operator (=, !=, before, after, between or in) after '%s' %s", variable, p.found())
+	}
+	condition.Operator = operator
+
+	value, err := p.name("a value")
+	if err != nil {
+		return condition, err
+	}
+	condition.Values = append(condition.Values, value)
+	return condition, nil
+}
+
+// parseValueList parses the "<value>, ...)" of an in list after its opening parenthesis
+func (p *parser) parseValueList() ([]string, error) {
+	var values []string
+	for {
+		value, err := p.name("a value")
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		switch p.peek() {
+		case ",":
+			p.pos++
+		case ")":
+			p.pos++
+			return values, nil
+		default:
+			return nil, p.errorf("expected ',' or ')' in the value list %s", p.found())
+		}
+	}
+}
+
+func isVerb(token string) bool {
+	for _, verb := range Verbs {
+		if token == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenize splits a statement into words, quoted strings, commas, braces, parentheses and the = and != operators.
+// Quoted parts stay within their word, so 'Default'/'Administrators' is a single token.
+func tokenize(statement string) ([]string, error) {
+	var tokens []string
+	var word strings.Builder
+	var quote rune
+
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+
+	runes := []rune(statement)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+
+		if quote != 0 {
+			word.WriteRune(c)
+			if c == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case c == '\'' || c == '"':
+			quote = c
+			word.WriteRune(c)
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			flush()
+		case c == ',' || c == '{' || c == '}' || c == '(' || c == ')' || c == '=':
+			flush()
+			tokens = append(tokens, string(c))
+		case c == '!' && i+1 < len(runes) && runes[i+1] == '=':
+			flush()
+			tokens = append(tokens, "!=")
+			i++
+		default:
+			word.WriteRune(c)
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	flush()
+	return tokens, nil
+}
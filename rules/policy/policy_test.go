@@ -0,0 +1,196 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Expected *Statement
+		Error    string
+	}{
+		{
+			Name:  "group in tenancy",
+			Input: "Allow group Administrators to manage all-resources in tenancy",
+			Expected: &Statement{
+				Action:       ActionAllow,
+				Subjects:     []Subject{{Kind: SubjectGroup, Name: "Administrators"}},
+				Verb:         "manage",
+				ResourceType: "all-resources",
+				Location:     Location{Kind: LocationTenancy},
+			},
+		},
+		{
+			Name:  "keywords are case insensitive",
+			Input: "ALLOW Group NetworkAdmins To Use VIRTUAL-NETWORK-FAMILY In Compartment Networks",
+			Expected: &Statement{
+				Action:       ActionAllow,
+				Subjects:     []Subject{{Kind: SubjectGroup, Name: "NetworkAdmins"}},
+				Verb:         "use",
+				ResourceType: "virtual-network-family",
+				Location:     Location{Kind: LocationCompartment, Name: "Networks"},
+			},
+		},
+		{
+			Name:  "subject list with identity domain and OCIDs",
+			Input: "Allow group 'Default'/'Ops', dynamic-group id ocid1.dynamicgroup.oc1..aaa, service objectstorage-us-ashburn-1 to read buckets in compartment id ocid1.compartment.oc1..bbb",
+			Expected: &Statement{
+				Action: ActionAllow,
+				Subjects: []Subject{
+					{Kind: SubjectGroup, Name: "'Default'/'Ops'"},
+					{Kind: SubjectDynamicGroup, Name: "ocid1.dynamicgroup.oc1..aaa", ByID: true},
+					{Kind: SubjectService, Name: "objectstorage-us-ashburn-1"},
+				},
+				Verb:         "read",
+				ResourceType: "buckets",
+				Location:     Location{Kind: LocationCompartment, Name: "ocid1.compartment.oc1..bbb", ByID: true},
+			},
+		},
+		{
+			Name:  "bare names continue the subject list",
+			Input: "Allow group A, B to inspect instances in compartment Apps:Prod",
+			Expected: &Statement{
+				Action:       ActionAllow,
+				Subjects:     []Subject{{Kind: SubjectGroup, Name: "A"}, {Kind: SubjectGroup, Name: "B"}},
+				Verb:         "inspect",
+				ResourceType: "instances",
+				Location:     Location{Kind: LocationCompartment, Name: "Apps:Prod"},
+			},
+		},
+		{
+			Name:  "permission list",
+			Input: "Allow any-group to {INSTANCE_READ, INSTANCE_INSPECT} in tenancy",
+			Expected: &Statement{
+				Action:      ActionAllow,
+				Subjects:    []Subject{{Kind: SubjectAnyGroup}},
+				Permissions: []string{"INSTANCE_READ", "INSTANCE_INSPECT"},
+				Location:    Location{Kind: LocationTenancy},
+			},
+		},
+		{
+			Name:  "single condition without spaces",
+			Input: "Allow any-user to read objects in compartment Public where request.principal.type='fnfunc'",
+			Expected: &Statement{
+				Action:       ActionAllow,
+				Subjects:     []Subject{{Kind: SubjectAnyUser}},
+				Verb:         "read",
+				ResourceType: "objects",
+				Location:     Location{Kind: LocationCompartment, Name: "Public"},
+				Where: &Where{Conditions: []Condition{
+					{Variable: "request.principal.type", Operator: "=", Values: []string{"'fnfunc'"}},
+				}},
+			},
+		},
+		{
+			Name:  "condition list",
+			Input: "Allow group Ops to manage instances in tenancy where any {request.permission != 'INSTANCE_DELETE', request.utc-timestamp.hour-of-day between 9 and 17}",
+			Expected: &Statement{
+				Action:       ActionAllow,
+				Subjects:     []Subject{{Kind: SubjectGroup, Name: "Ops"}},
+				Verb:         "manage",
+				ResourceType: "instances",
+				Location:     Location{Kind: LocationTenancy},
+				Where: &Where{Quantifier: "any", Conditions: []Condition{
+					{Variable: "request.permission", Operator: "!=", Values: []string{"'INSTANCE_DELETE'"}},
+					{Variable: "request.utc-timestamp.hour-of-day", Operator: "between", Values: []string{"9", "17"}},
+				}},
+			},
+		},
+		{
+			Name:  "in value list",
+			Input: "Allow group Devs to manage all-resources in tenancy where request.utc-timestamp.day-of-week in ('monday', 'tuesday')",
+			Expected: &Statement{
+				Action:       ActionAllow,
+				Subjects:     []Subject{{Kind: SubjectGroup, Name: "Devs"}},
+				Verb:         "manage",
+				ResourceType: "all-resources",
+				Location:     Location{Kind: LocationTenancy},
+				Where: &Where{Conditions: []Condition{
+					{Variable: "request.utc-timestamp.day-of-week", Operator: "in", Values: []string{"'monday'", "'tuesday'"}},
+				}},
+			},
+		},
+		{
+			Name:  "nested condition list",
+			Input: "Allow group Ops to use buckets in tenancy where all {request.region = 'iad', any {target.bucket.name = 'logs', target.bucket.name in ('audit','archive')}}",
+			Expected: &Statement{
+				Action:       ActionAllow,
+				Subjects:     []Subject{{Kind: SubjectGroup, Name: "Ops"}},
+				Verb:         "use",
+				ResourceType: "buckets",
+				Location:     Location{Kind: LocationTenancy},
+				Where: &Where{Quantifier: "all", Conditions: []Condition{
+					{Variable: "request.region", Operator: "=", Values: []string{"'iad'"}},
+					{Group: &Where{Quantifier: "any", Conditions: []Condition{
+						{Variable: "target.bucket.name", Operator: "=", Values: []string{"'logs'"}},
+						{Variable: "target.bucket.name", Operator: "in", Values: []string{"'audit'", "'archive'"}},
+					}}},
+				}},
+			},
+		},
+		{
+			Name:  "endorse into another tenancy",
+			Input: "Endorse group Replicators to manage object-family in tenancy Backup",
+			Expected: &Statement{
+				Action:       ActionEndorse,
+				Subjects:     []Subject{{Kind: SubjectGroup, Name: "Replicators"}},
+				Verb:         "manage",
+				ResourceType: "object-family",
+				Location:     Location{Kind: LocationTenancy, Name: "Backup"},
+			},
+		},
+		{
+			Name:  "admit from another tenancy",
+			Input: "Admit group Replicators of tenancy Source to manage object-family in compartment Backups",
+			Expected: &Statement{
+				Action:       ActionAdmit,
+				Subjects:     []Subject{{Kind: SubjectGroup, Name: "Replicators", Tenancy: "Source"}},
+				Verb:         "manage",
+				ResourceType: "object-family",
+				Location:     Location{Kind: LocationCompartment, Name: "Backups"},
+			},
+		},
+		{
+			Name:     "define",
+			Input:    "Define tenancy Source as ocid1.tenancy.oc1..aaa",
+			Expected: &Statement{Action: ActionDefine, Alias: "Source", OCID: "ocid1.tenancy.oc1..aaa"},
+		},
+		{Name: "unknown action", Input: "Permit group A to read buckets in tenancy", Error: "statement must start with Allow, Endorse, Admit or Define but found 'Permit'"},
+		{Name: "unclosed permission list", Input: "Allow group A to {BUCKET_READ", Error: "expected ',' or '}' in the permission list at the end of the statement"},
+		{Name: "unknown verb", Input: "Allow group A to mange buckets in tenancy", Error: "unknown verb 'mange', expected inspect, read, use or manage"},
+		{Name: "missing subject", Input: "Allow to read buckets in tenancy", Error: "expected a subject (group, dynamic-group, service, any-user or any-group) but found 'to'"},
+		{Name: "missing resource type", Input: "Allow group A to read in tenancy", Error: "expected a resource type after 'read'"},
+		{Name: "missing location", Input: "Allow group A to read buckets", Error: "expected 'in' at the end of the statement"},
+		{Name: "unknown location", Input: "Allow group A to read buckets in region", Error: "expected 'tenancy' or 'compartment' but found 'region'"},
+		{Name: "trailing tokens", Input: "Allow group A to read buckets in tenancy Acme", Error: "unexpected 'Acme' after the end of the statement"},
+		{Name: "unknown condition variable", Input: "Allow group A to read buckets in tenancy where bucket.name = 'logs'", Error: "unknown condition variable 'bucket.name', variables start with 'request.' or 'target.'"},
+		{Name: "missing operator", Input: "Allow group A to read buckets in tenancy where target.bucket.name 'logs'", Error: "expected an operator (=, !=, before, after, between or in) after 'target.bucket.name' but found ''logs''"},
+		{Name: "unclosed condition list", Input: "Allow group A to read buckets in tenancy where all {target.bucket.name = 'logs'", Error: "expected ',' or '}' in the condition list at the end of the statement"},
+		{Name: "unclosed value list", Input: "Allow group A to read buckets in tenancy where target.bucket.name in ('logs' 'audit')", Error: "expected ',' or ')' in the value list but found ''audit''"},
+		{Name: "unterminated quote", Input: "Allow group A to read buckets in tenancy where target.bucket.name = 'logs", Error: "unterminated ' quote"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			statement, err := Parse(tc.Input)
+			if tc.Error != "" {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", statement)
+				}
+				if err.Error() != tc.Error {
+					t.Fatalf("expected error %q, got %q", tc.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(statement, tc.Expected) {
+				t.Fatalf("expected %+v, got %+v", tc.Expected, statement)
+			}
+		})
+	}
+}
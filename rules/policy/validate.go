@@ -0,0 +1,193 @@
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// resourceTypes are the resource families and individual resource types of the policy reference. The list
+// is not exhaustive, so unknown resource types are only reported when they look like a misspelling of a known
+// one, unless ValidateOptions.ReportUnknownResourceTypes is set.
+var resourceTypes = []string{
+	"all-resources",
+
+	// Compute
+	"instance-family", "instances", "compute-container-family", "compute-container-instances", "compute-containers", "instance-images", "instance-console-connection", "console-histories",
+	"app-catalog-listing", "app-catalog-listings", "volume-attachments", "vnic-attachments", "dedicated-vm-hosts",
+	"compute-capacity-reservations", "compute-clusters", "compute-image-capability-schema", "instance-agent-plugins",
+	"instance-agent-command-family", "instance-agent-commands", "instance-agent-command-executions",
+	"compute-management-family", "instance-configurations", "instance-pools", "cluster-networks",
+
+	// Block Volume
+	"volume-family", "volumes", "volume-backups", "boot-volumes", "boot-volume-backups", "volume-groups",
+	"volume-group-backups", "volume-group-replicas", "block-volume-replicas", "boot-volume-replicas",
+	"backup-policies", "backup-policy-assignments",
+
+	// Networking
+	"virtual-network-family", "vcns", "subnets", "route-tables", "network-security-groups", "security-lists",
+	"dhcp-options", "private-ips", "public-ips", "ipv6s", "internet-gateways", "nat-gateways", "service-gateways",
+	"local-peering-gateways", "remote-peering-connections", "drgs", "drg-attachments", "drg-route-tables",
+	"drg-route-distributions", "cpes", "ipsec-connections", "cross-connects", "cross-connect-groups",
+	"virtual-circuits", "vnics", "vlans", "byoip-ranges", "public-ip-pools", "vtaps", "capture-filters",
+	"load-balancers", "network-load-balancers", "network-firewall-family", "network-firewalls",
+	"network-firewall-policies",
+
+	// Object and File Storage
+	"object-family", "buckets", "objects", "objectstorage-namespaces",
+	"file-family", "file-systems", "mount-targets", "export-sets", "replications", "filesystem-snapshot-policies",
+
+	// Database
+	"database-family", "db-systems", "db-nodes", "db-homes", "databases", "pluggable-databases", "backups",
+	"exadata-infrastructures", "vmclusters", "cloud-exadata-infrastructures", "cloud-vmclusters",
+	"autonomous-database-family", "autonomous-databases", "autonomous-backups", "autonomous-container-databases",
+	"autonomous-vmclusters", "autonomous-exadata-infrastructures", "cloud-autonomous-vmclusters",
+	"db-backups", "backup-destinations", "database-software-images", "key-stores", "data-safe-family",
+	"mysql-family", "mysql-instances", "mysql-backups", "mysql-configurations", "mysql-channels",
+	"psql-family", "postgres-db-systems", "postgres-backups", "postgres-configurations",
+	"nosql-family", "nosql-tables", "nosql-rows", "nosql-indexes",
+	"goldengate-family", "opensearch-family",
+
+	// Containers, Functions and API Gateway
+	"cluster-family", "clusters", "cluster-node-pools", "cluster-virtualnode-pools", "cluster-workrequests",
+	"repos", "generic-artifacts",
+	"functions-family", "fn-app", "fn-function", "fn-invocation",
+	"api-gateway-family", "api-gateways", "api-deployments",
+
+	// Identity
+	"users", "groups", "dynamic-groups", "policies", "compartments", "tenancies", "tag-namespaces", "tag-defaults",
+	"identity-providers", "authentication-policies", "network-sources", "domains",
+
+	// Security
+	"secret-family", "secrets", "secret-bundles", "vaults", "keys", "key-delegate",
+	"cloud-guard-family", "bastion-family", "bastion", "bastions", "bastion-session", "bastion-sessions",
+	"certificate-authority-family", "leaf-certificate-family", "waas-family", "waf-family",
+	"vss-family", "vss-host-scan-recipes", "vss-host-scan-targets", "vss-container-scan-recipes",
+	"vss-container-scan-targets", "security-zone-family",
+
+	// Observability, messaging and DNS
+	"logging-family", "log-groups", "log-content", "unified-configuration", "metrics", "alarms", "audit-events",
+	"ons-family", "ons-topics", "ons-subscriptions",
+	"stream-family", "streams", "stream-push", "stream-pull", "stream-pools", "connect-harness",
+	"email-family", "email-senders", "email-domains", "suppressions",
+	"dns", "dns-zones", "dns-records", "dns-steering-policies", "dns-resolvers", "dns-views",
+	"usage-budgets", "usage-report", "cloudevents-rules", "serviceconnectors", "management-agents",
+	"osms-family", "osmh-family", "apm-domains", "announcements",
+
+	// Resource Manager and Cloud Shell
+	"orm-family", "orm-stacks", "orm-jobs", "orm-config-source-providers", "orm-templates", "orm-private-endpoints",
+	"cloud-shell",
+
+	// Developer and data services
+	"devops-family", "data-science-family", "data-catalog-family", "dataflow-family", "oda-family",
+	"integration-instances", "analytics-instances", "generative-ai-family", "ai-service-language-family",
+	"ai-service-vision-family", "ai-service-speech-family", "ai-service-document-family", "database-tools-family",
+	"bds-instances",
+}
+
+// ValidateOptions adjust how Validate treats resource types
+type ValidateOptions struct {
+	// AdditionalResourceTypes are accepted on top of the known resource types
+	AdditionalResourceTypes []string
+	// ReportUnknownResourceTypes reports every resource type that isn't known, not only likely misspellings
+	ReportUnknownResourceTypes bool
+}
+
+// Validate parses a statement and checks it for mistakes the grammar accepts: misspelled resource types,
+// and compartments, groups and dynamic groups named by OCID without the "id" keyword or the other way round
+func Validate(statement string, opts ValidateOptions) []error {
+	s, err := Parse(statement)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, &Error{Statement: statement, Message: fmt.Sprintf(format, args...)})
+	}
+
+	for _, subject := range s.Subjects {
+		if subject.Kind == SubjectGroup || subject.Kind == SubjectDynamicGroup {
+			if message := idMismatch(subject.Kind, subject.Name, subject.ByID); message != "" {
+				fail("%s", message)
+			}
+		}
+	}
+
+	if s.ResourceType != "" {
+		known := append(append([]string{}, resourceTypes...), opts.AdditionalResourceTypes...)
+		if !slices.Contains(known, s.ResourceType) {
+			if suggestion := closestResourceType(s.ResourceType, known); suggestion != "" {
+				fail("unknown resource type '%s', did you mean '%s'?", s.ResourceType, suggestion)
+			} else if opts.ReportUnknownResourceTypes {
+				fail("unknown resource type '%s'", s.ResourceType)
+			}
+		}
+	}
+
+	if s.Location.Kind == LocationCompartment {
+		if message := idMismatch(LocationCompartment, s.Location.Name, s.Location.ByID); message != "" {
+			fail("%s", message)
+		}
+	}
+	return errs
+}
+
+// idMismatch describes a name used with the "id" keyword, or an OCID used without it
+func idMismatch(kind string, name string, byID bool) string {
+	isOCID := strings.HasPrefix(strings.ToLower(name), "ocid1.")
+	switch {
+	case byID && !isOCID:
+		return fmt.Sprintf("'%s id' expects an OCID but found '%s', use '%s %s' to refer to it by name", kind, name, kind, name)
+	case !byID && isOCID:
+		return fmt.Sprintf("'%s' is an OCID, use '%s id %s' to refer to a %s by OCID", name, kind, name, kind)
+	}
+	return ""
+}
+
+// closestResourceType returns the known resource type closest to the given one, or an empty string when none
+// is close enough to be a likely misspelling. Names up to 5 characters allow one edit and longer names two, so
+// that e.g. "instnaces" suggests "instances". Families are never suggested for other family names, as an
+// unknown family is more likely missing from the list than misspelled.
+func closestResourceType(resourceType string, known []string) string {
+	maxDistance := 2
+	switch {
+	case len(resourceType) < 4:
+		return ""
+	case len(resourceType) <= 5:
+		maxDistance = 1
+	}
+
+	closest, closestDistance := "", maxDistance+1
+	for _, candidate := range known {
+		if strings.HasSuffix(resourceType, "-family") && strings.HasSuffix(candidate, "-family") {
+			continue
+		}
+		if distance := editDistance(resourceType, candidate); distance < closestDistance {
+			closest, closestDistance = candidate, distance
+		}
+	}
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
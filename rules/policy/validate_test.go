@@ -0,0 +1,143 @@
+package policy
+
+import (
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Options  ValidateOptions
+		Expected []string
+	}{
+		{
+			Name:  "valid statement",
+			Input: "Allow group id ocid1.group.oc1..aaa to use instance-family in compartment id ocid1.compartment.oc1..bbb",
+		},
+		{
+			Name:  "ai service family",
+			Input: "Allow group Ops to read ai-service-vision-family in tenancy",
+		},
+		{
+			Name:  "postgres family",
+			Input: "Allow group Ops to manage psql-family in tenancy",
+		},
+		{
+			Name:  "vulnerability scanning family",
+			Input: "Allow group Ops to manage vss-family in tenancy",
+		},
+		{
+			Name:  "os management family",
+			Input: "Allow group Ops to manage osms-family in tenancy",
+		},
+		{
+			Name:  "digital assistant family",
+			Input: "Allow group Ops to manage oda-family in tenancy",
+		},
+		{
+			Name:  "plural bastions",
+			Input: "Allow group Ops to manage bastions in tenancy",
+		},
+		{
+			Name:  "plural app catalog listings",
+			Input: "Allow group Ops to read app-catalog-listings in tenancy",
+		},
+		{
+			Name:  "uncatalogued family close to a known family",
+			Input: "Allow group Ops to manage ors-family in tenancy",
+		},
+		{
+			Name:     "uncatalogued family reported when requested",
+			Input:    "Allow group Ops to manage ors-family in tenancy",
+			Options:  ValidateOptions{ReportUnknownResourceTypes: true},
+			Expected: []string{"unknown resource type 'ors-family'"},
+		},
+		{
+			Name:  "additional resource type",
+			Input: "Allow group Ops to manage ors-family in tenancy",
+			Options: ValidateOptions{
+				AdditionalResourceTypes:    []string{"ors-family"},
+				ReportUnknownResourceTypes: true,
+			},
+		},
+		{
+			Name:  "cloud shell",
+			Input: "Allow group Ops to use cloud-shell in tenancy",
+		},
+		{
+			Name:  "resource manager family",
+			Input: "Allow group Ops to manage orm-family in tenancy",
+		},
+		{
+			Name:  "container instances family",
+			Input: "Allow group Ops to manage compute-container-family in compartment Apps",
+		},
+		{
+			Name:     "resource type two edits away",
+			Input:    "Allow group Ops to manage instnaces in tenancy",
+			Expected: []string{"unknown resource type 'instnaces', did you mean 'instances'?"},
+		},
+		{
+			Name:  "short resource type",
+			Input: "Allow group Ops to read vcm in tenancy",
+		},
+		{
+			Name:  "uncatalogued resource type",
+			Input: "Allow group Ops to manage widgets in tenancy",
+		},
+		{
+			Name:     "uncatalogued resource type reported when requested",
+			Input:    "Allow group Ops to manage widgets in tenancy",
+			Options:  ValidateOptions{ReportUnknownResourceTypes: true},
+			Expected: []string{"unknown resource type 'widgets'"},
+		},
+		{
+			Name:     "syntax error",
+			Input:    "Allow group Ops to mange buckets in tenancy",
+			Expected: []string{"unknown verb 'mange', expected inspect, read, use or manage"},
+		},
+		{
+			Name:     "misspelled family",
+			Input:    "Allow group Ops to manage instance-familly in tenancy",
+			Expected: []string{"unknown resource type 'instance-familly', did you mean 'instance-family'?"},
+		},
+		{
+			Name:     "singular resource type",
+			Input:    "Allow group Ops to read Bucket in tenancy",
+			Expected: []string{"unknown resource type 'bucket', did you mean 'buckets'?"},
+		},
+		{
+			Name:     "compartment OCID without id",
+			Input:    "Allow group Ops to read buckets in compartment ocid1.compartment.oc1..aaa",
+			Expected: []string{"'ocid1.compartment.oc1..aaa' is an OCID, use 'compartment id ocid1.compartment.oc1..aaa' to refer to a compartment by OCID"},
+		},
+		{
+			Name:     "compartment name with id",
+			Input:    "Allow group Ops to read buckets in compartment id Apps",
+			Expected: []string{"'compartment id' expects an OCID but found 'Apps', use 'compartment Apps' to refer to it by name"},
+		},
+		{
+			Name:  "group mismatches",
+			Input: "Allow group ocid1.group.oc1..aaa, dynamic-group id Functions to read buckets in tenancy",
+			Expected: []string{
+				"'ocid1.group.oc1..aaa' is an OCID, use 'group id ocid1.group.oc1..aaa' to refer to a group by OCID",
+				"'dynamic-group id' expects an OCID but found 'Functions', use 'dynamic-group Functions' to refer to it by name",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			errs := Validate(tc.Input, tc.Options)
+			if len(errs) != len(tc.Expected) {
+				t.Fatalf("expected %d errors, got %v", len(tc.Expected), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.Expected[i] {
+					t.Errorf("expected error %q, got %q", tc.Expected[i], err)
+				}
+			}
+		})
+	}
+}
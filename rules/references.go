@@ -0,0 +1,26 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+)
+
+// referencedResourceNames returns the names of the resources of the given type referenced in the expression,
+// e.g. "main" for `oci_core_subnet.main.id` when resourceType is "oci_core_subnet"
+func referencedResourceNames(expr hcl.Expression, resourceType string) []string {
+	var names []string
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() != resourceType || len(traversal) < 2 {
+			continue
+		}
+
+		if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+			names = append(names, attr.Name)
+		}
+	}
+	return names
+}
+
+// resourceAddress returns the Terraform address of a resource block, e.g. "oci_core_instance.bastion"
+func resourceAddress(labels []string) string {
+	return labels[0] + "." + labels[1]
+}
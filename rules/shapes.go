@@ -0,0 +1,111 @@
+package rules
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// shapes.json follows the OCI compute shapes reference at
+// https://docs.oracle.com/en-us/iaas/Content/Compute/References/computeshapes.htm, last checked 2026-10-19
+//
+//go:embed shapes.json
+var shapeCatalogJSON []byte
+
+// shieldedShapeSupport describes which shielded instance features a shape accepts
+type shieldedShapeSupport struct {
+	SecureBoot   bool `json:"secure_boot"`
+	MeasuredBoot bool `json:"measured_boot"`
+	TPM          bool `json:"tpm"`
+}
+
+// shapeSpec describes the sizing limits and features of a compute shape
+type shapeSpec struct {
+	Name             string               `json:"name"`
+	Flex             bool                 `json:"flex"`
+	MinOCPUs         float64              `json:"min_ocpus"`
+	MaxOCPUs         float64              `json:"max_ocpus"`
+	MinMemoryPerOCPU float64              `json:"min_memory_per_ocpu"`
+	MaxMemoryPerOCPU float64              `json:"max_memory_per_ocpu"`
+	MaxMemoryInGBs   float64              `json:"max_memory_in_gbs"`
+	Shielded         shieldedShapeSupport `json:"shielded"`
+}
+
+// shapeCatalog is the catalog of known compute shapes, keyed by shape name
+var shapeCatalog = loadShapeCatalog()
+
+func loadShapeCatalog() map[string]shapeSpec {
+	var shapes []shapeSpec
+	if err := json.Unmarshal(shapeCatalogJSON, &shapes); err != nil {
+		panic(err)
+	}
+
+	catalog := make(map[string]shapeSpec, len(shapes))
+	for _, shape := range shapes {
+		catalog[shape.Name] = shape
+	}
+	return catalog
+}
+
+// shapeTarget is a resource launching compute instances with a shape and an optional shape_config
+type shapeTarget struct {
+	// subject describes the resource in issue messages
+	subject  string
+	resource *hclext.Block
+	// body holds the shape attribute and shape_config blocks
+	body *hclext.BodyContent
+}
+
+var shapeSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "shape"},
+	},
+	Blocks: []hclext.BlockSchema{
+		{
+			Type: "shape_config",
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{
+					{Name: "ocpus"},
+					{Name: "memory_in_gbs"},
+				},
+			},
+		},
+	},
+}
+
+// shapeTargets returns the compute instances and instance configuration launch details declaring a shape
+func shapeTargets(runner tflint.Runner) ([]shapeTarget, error) {
+	var targets []shapeTarget
+
+	instances, err := runner.GetResourceContent("oci_core_instance", shapeSchema, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range instances.Blocks {
+		targets = append(targets, shapeTarget{
+			subject:  fmt.Sprintf("OCI Compute Instance '%s'", resource.Labels[0]),
+			resource: resource,
+			body:     resource.Body,
+		})
+	}
+
+	configurations, err := runner.GetResourceContent("oci_core_instance_configuration", launchDetailsSchema(shapeSchema), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range configurations.Blocks {
+		for _, launchDetails := range launchDetailsBlocks(resource) {
+			targets = append(targets, shapeTarget{
+				subject:  fmt.Sprintf("OCI Instance Configuration '%s'", resource.Labels[0]),
+				resource: resource,
+				body:     launchDetails.Body,
+			})
+		}
+	}
+	return targets, nil
+}
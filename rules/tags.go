@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// tagAttributeSchemas are the attributes holding the tags of a resource
+var tagAttributeSchemas = []hclext.AttributeSchema{
+	{Name: "freeform_tags"},
+	{Name: "defined_tags"},
+}
+
+// resourceTags returns the freeform and defined tags of a resource body.
+// Defined tags are keyed by "Namespace.key". Tags that can't be evaluated are ignored.
+func resourceTags(runner tflint.Runner, body *hclext.BodyContent) map[string]string {
+	tags := map[string]string{}
+	for _, schema := range tagAttributeSchemas {
+		attr, exists := body.Attributes[schema.Name]
+		if !exists {
+			continue
+		}
+
+		var values map[string]string
+		if err := runner.EvaluateExpr(attr.Expr, &values, nil); err != nil {
+			continue
+		}
+		for key, value := range values {
+			tags[key] = value
+		}
+	}
+	return tags
+}
+
+// matchesAnyTag reports whether the tags contain any of the wanted key/value pairs
+func matchesAnyTag(tags map[string]string, wanted map[string]string) bool {
+	for key, value := range wanted {
+		if v, exists := tags[key]; exists && v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/joelp172/tflint-ruleset-oci/rules/cidr"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// vcnAddressSpaces returns the CIDR blocks of each VCN declared in the module, keyed by name.
+// VCNs whose CIDR blocks can't be evaluated are omitted, and blocks with host bits set are ignored.
+func vcnAddressSpaces(runner tflint.Runner) (map[string][]netip.Prefix, error) {
+	vcns, err := runner.GetResourceContent("oci_core_vcn", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "cidr_block"},
+			{Name: "cidr_blocks"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	spaces := map[string][]netip.Prefix{}
+	for _, vcn := range vcns.Blocks {
+		var blocks []string
+		if attr, exists := vcn.Body.Attributes["cidr_blocks"]; exists {
+			if err := runner.EvaluateExpr(attr.Expr, &blocks, nil); err != nil {
+				continue
+			}
+		}
+		if attr, exists := vcn.Body.Attributes["cidr_block"]; exists {
+			var block string
+			if err := runner.EvaluateExpr(attr.Expr, &block, nil); err != nil {
+				continue
+			}
+			blocks = append(blocks, block)
+		}
+
+		var prefixes []netip.Prefix
+		for _, block := range blocks {
+			if prefix, err := cidr.ParseCanonical(block); err == nil {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+		if len(prefixes) > 0 {
+			spaces[vcn.Labels[1]] = prefixes
+		}
+	}
+	return spaces, nil
+}
+
+// subnetCIDR is a subnet declared in the module with an evaluable CIDR block
+type subnetCIDR struct {
+	resource *hclext.Block
+	// attr is the cidr_block attribute
+	attr   *hclext.Attribute
+	prefix netip.Prefix
+	// block is the cidr_block value, canonical is false when it has host bits set and prefix holds the masked block
+	block     string
+	canonical bool
+	// vcn is the name of the oci_core_vcn referenced by vcn_id, empty when it isn't declared in the module
+	vcn string
+}
+
+// subnetCIDRs returns the subnets declared in the module whose cidr_block can be evaluated
+func subnetCIDRs(runner tflint.Runner) ([]subnetCIDR, error) {
+	subnets, err := runner.GetResourceContent("oci_core_subnet", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "cidr_block"},
+			{Name: "vcn_id"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []subnetCIDR
+	for _, subnet := range subnets.Blocks {
+		attr, exists := subnet.Body.Attributes["cidr_block"]
+		if !exists {
+			continue
+		}
+
+		var block string
+		if err := runner.EvaluateExpr(attr.Expr, &block, nil); err != nil {
+			continue
+		}
+		prefix, err := cidr.Parse(block)
+		if err != nil {
+			continue
+		}
+		_, err = cidr.ParseCanonical(block)
+		canonical := err == nil
+
+		var vcn string
+		if vcnAttr, exists := subnet.Body.Attributes["vcn_id"]; exists {
+			if names := referencedResourceNames(vcnAttr.Expr, "oci_core_vcn"); len(names) > 0 {
+				vcn = names[0]
+			}
+		}
+
+		result = append(result, subnetCIDR{resource: subnet, attr: attr, prefix: prefix, block: block, canonical: canonical, vcn: vcn})
+	}
+	return result, nil
+}
+
+// addressSpacesOverlap returns the first pair of overlapping blocks between two address spaces
+func addressSpacesOverlap(a, b []netip.Prefix) (netip.Prefix, netip.Prefix, bool) {
+	for _, x := range a {
+		for _, y := range b {
+			if cidr.Overlaps(x, y) {
+				return x, y, true
+			}
+		}
+	}
+	return netip.Prefix{}, netip.Prefix{}, false
+}
+
+// formatPrefixes formats an address space as a comma separated list
+func formatPrefixes(prefixes []netip.Prefix) string {
+	blocks := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		blocks[i] = prefix.String()
+	}
+	return strings.Join(blocks, ", ")
+}